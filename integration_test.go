@@ -49,7 +49,7 @@ func integrationClient(t *testing.T) *api.Client {
 
 func TestIntegrationSearchMovie(t *testing.T) {
 	client := integrationClient(t)
-	resp, err := client.SearchMovies("The Matrix")
+	resp, err := client.SearchMovies("The Matrix", api.SearchOptions{})
 	if err != nil {
 		t.Fatalf("SearchMovies: %v", err)
 	}
@@ -72,7 +72,7 @@ func TestIntegrationSearchMovie(t *testing.T) {
 
 func TestIntegrationSearchTV(t *testing.T) {
 	client := integrationClient(t)
-	resp, err := client.SearchTV("Breaking Bad")
+	resp, err := client.SearchTV("Breaking Bad", api.SearchOptions{})
 	if err != nil {
 		t.Fatalf("SearchTV: %v", err)
 	}
@@ -92,7 +92,7 @@ func TestIntegrationSearchTV(t *testing.T) {
 
 func TestIntegrationSearchPerson(t *testing.T) {
 	client := integrationClient(t)
-	resp, err := client.SearchPerson("Brad Pitt")
+	resp, err := client.SearchPerson("Brad Pitt", api.SearchOptions{})
 	if err != nil {
 		t.Fatalf("SearchPerson: %v", err)
 	}
@@ -113,6 +113,17 @@ func TestIntegrationSearchPerson(t *testing.T) {
 	}
 }
 
+func TestIntegrationFindByIMDbID(t *testing.T) {
+	client := integrationClient(t)
+	resp, err := client.FindByExternalID("imdb", "tt0133093")
+	if err != nil {
+		t.Fatalf("FindByExternalID: %v", err)
+	}
+	if len(resp.MovieResults) == 0 || resp.MovieResults[0].ID != testMovieID {
+		t.Errorf("expected movie ID %d, got %+v", testMovieID, resp.MovieResults)
+	}
+}
+
 func TestIntegrationFilmography(t *testing.T) {
 	client := integrationClient(t)
 	resp, err := client.Filmography(testPersonID)
@@ -254,6 +265,39 @@ func TestIntegrationWatchlistAddAndRemove(t *testing.T) {
 	}
 }
 
+func TestIntegrationFavoriteAddAndRemove(t *testing.T) {
+	client := integrationClient(t)
+	if os.Getenv("TMDB_SESSION_ID") == "" {
+		t.Skip("TMDB_SESSION_ID not set, skipping write test")
+	}
+
+	err := client.AddFavorite("movie", testMovieID)
+	if err != nil {
+		t.Fatalf("AddFavorite: %v", err)
+	}
+
+	time.Sleep(2 * time.Second)
+
+	movies, err := client.GetFavoriteMovies()
+	if err != nil {
+		t.Fatalf("GetFavoriteMovies: %v", err)
+	}
+	found := false
+	for _, m := range movies {
+		if m.ID == testMovieID {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("movie not found in favorites after adding")
+	}
+
+	err = client.RemoveFavorite("movie", testMovieID)
+	if err != nil {
+		t.Fatalf("RemoveFavorite: %v", err)
+	}
+}
+
 func TestIntegrationRatedMoviesList(t *testing.T) {
 	client := integrationClient(t)
 	if os.Getenv("TMDB_ACCOUNT_OBJECT_ID") == "" {
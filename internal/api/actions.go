@@ -101,3 +101,16 @@ func (c *Client) AddFavorite(mediaType string, mediaID int) error {
 	}
 	return nil
 }
+
+func (c *Client) RemoveFavorite(mediaType string, mediaID int) error {
+	payload := map[string]any{
+		"media_type": mediaType,
+		"media_id":   mediaID,
+		"favorite":   false,
+	}
+	_, err := c.post(fmt.Sprintf("/account/%d/favorite", c.accountID), payload)
+	if err != nil {
+		return fmt.Errorf("removing favorite: %w", err)
+	}
+	return nil
+}
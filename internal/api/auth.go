@@ -47,3 +47,42 @@ func (c *Client) GetAccount() (*AccountResponse, error) {
 	}
 	return &resp, nil
 }
+
+// CreateV4RequestToken starts the V4 user authentication flow. The token must
+// be approved at V4ApproveURL before CreateV4AccessToken.
+func (c *Client) CreateV4RequestToken() (string, error) {
+	data, err := c.sendV4("POST", "/auth/request_token", map[string]string{})
+	if err != nil {
+		return "", fmt.Errorf("creating V4 request token: %w", err)
+	}
+	var resp RequestTokenResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return "", err
+	}
+	if !resp.Success {
+		return "", fmt.Errorf("failed to create V4 request token")
+	}
+	return resp.RequestToken, nil
+}
+
+func V4ApproveURL(requestToken string) string {
+	return "https://www.themoviedb.org/auth/access?request_token=" + requestToken
+}
+
+// CreateV4AccessToken exchanges an approved request token for a user access
+// token with write access.
+func (c *Client) CreateV4AccessToken(requestToken string) (*AccessTokenResponse, error) {
+	payload := map[string]string{"request_token": requestToken}
+	data, err := c.sendV4("POST", "/auth/access_token", payload)
+	if err != nil {
+		return nil, fmt.Errorf("creating V4 access token: %w", err)
+	}
+	var resp AccessTokenResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("failed to create V4 access token")
+	}
+	return &resp, nil
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// changeParams builds the date window for change queries. Dates are
+// YYYY-MM-DD; TMDB accepts windows of up to 14 days.
+func changeParams(start, end string, page int) url.Values {
+	params := url.Values{}
+	if start != "" {
+		params.Set("start_date", start)
+	}
+	if end != "" {
+		params.Set("end_date", end)
+	}
+	if page > 0 {
+		params.Set("page", strconv.Itoa(page))
+	}
+	return params
+}
+
+// ChangedIDs lists the movies, TV shows or people changed in the window.
+func (c *Client) ChangedIDs(mediaType, start, end string, page int) (*ChangedIDsResponse, error) {
+	data, err := c.get(fmt.Sprintf("/%s/changes", mediaType), changeParams(start, end, page))
+	if err != nil {
+		return nil, fmt.Errorf("getting %s changes: %w", mediaType, err)
+	}
+	var resp ChangedIDsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) itemChanges(path, start, end, what string) ([]Change, error) {
+	data, err := c.get(path, changeParams(start, end, 0))
+	if err != nil {
+		return nil, fmt.Errorf("getting %s changes: %w", what, err)
+	}
+	var resp ChangesResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Changes, nil
+}
+
+func (c *Client) MovieChanges(movieID int, start, end string) ([]Change, error) {
+	return c.itemChanges(fmt.Sprintf("/movie/%d/changes", movieID), start, end, "movie")
+}
+
+func (c *Client) TVChanges(seriesID int, start, end string) ([]Change, error) {
+	return c.itemChanges(fmt.Sprintf("/tv/%d/changes", seriesID), start, end, "TV")
+}
+
+func (c *Client) PersonChanges(personID int, start, end string) ([]Change, error) {
+	return c.itemChanges(fmt.Sprintf("/person/%d/changes", personID), start, end, "person")
+}
+
+// Summary describes a change's new value briefly: release dates by type and
+// date, seasons by number, plain values as they are.
+func (i ChangeItem) Summary() string {
+	value := i.Value
+	if i.Action == "deleted" && len(i.OriginalValue) > 0 {
+		value = i.OriginalValue
+	}
+	if len(value) == 0 || string(value) == "null" {
+		return ""
+	}
+	var s string
+	if json.Unmarshal(value, &s) == nil {
+		return truncateValue(s)
+	}
+	var known struct {
+		ReleaseDate  string `json:"release_date"`
+		Type         int    `json:"type"`
+		SeasonNumber *int   `json:"season_number"`
+		Name         string `json:"name"`
+	}
+	if json.Unmarshal(value, &known) == nil {
+		switch {
+		case known.ReleaseDate != "":
+			return fmt.Sprintf("%s %s", ReleaseTypeName(known.Type), dateOnly(known.ReleaseDate))
+		case known.SeasonNumber != nil:
+			return fmt.Sprintf("season %d", *known.SeasonNumber)
+		case known.Name != "":
+			return known.Name
+		}
+	}
+	return truncateValue(string(value))
+}
+
+func truncateValue(s string) string {
+	const max = 60
+	if r := []rune(s); len(r) > max {
+		return string(r[:max]) + "…"
+	}
+	return s
+}
+
+func dateOnly(ts string) string {
+	if len(ts) >= 10 {
+		return ts[:10]
+	}
+	return ts
+}
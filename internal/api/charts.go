@@ -0,0 +1,106 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// chartParams is pageParams plus the region movie charts are filtered by.
+func chartParams(page int, region string) url.Values {
+	params := pageParams(page)
+	if region != "" {
+		if params == nil {
+			params = url.Values{}
+		}
+		params.Set("region", region)
+	}
+	return params
+}
+
+func (c *Client) movieChart(path string, params url.Values, what string) (*ReleaseWindowResponse, error) {
+	data, err := c.get(path, params)
+	if err != nil {
+		return nil, fmt.Errorf("getting %s: %w", what, err)
+	}
+	var resp ReleaseWindowResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) tvChart(path string, params url.Values, what string) (*SearchTVResponse, error) {
+	data, err := c.get(path, params)
+	if err != nil {
+		return nil, fmt.Errorf("getting %s: %w", what, err)
+	}
+	var resp SearchTVResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) personChart(path string, params url.Values, what string) (*SearchPersonResponse, error) {
+	data, err := c.get(path, params)
+	if err != nil {
+		return nil, fmt.Errorf("getting %s: %w", what, err)
+	}
+	var resp SearchPersonResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// TrendingMovies lists trending movies for the "day" or "week" time window.
+func (c *Client) TrendingMovies(window string, page int) (*ReleaseWindowResponse, error) {
+	return c.movieChart("/trending/movie/"+window, chartParams(page, ""), "trending movies")
+}
+
+func (c *Client) TrendingTV(window string, page int) (*SearchTVResponse, error) {
+	return c.tvChart("/trending/tv/"+window, chartParams(page, ""), "trending TV")
+}
+
+func (c *Client) TrendingPeople(window string, page int) (*SearchPersonResponse, error) {
+	return c.personChart("/trending/person/"+window, chartParams(page, ""), "trending people")
+}
+
+func (c *Client) PopularMovies(page int, region string) (*ReleaseWindowResponse, error) {
+	return c.movieChart("/movie/popular", chartParams(page, region), "popular movies")
+}
+
+func (c *Client) TopRatedMovies(page int, region string) (*ReleaseWindowResponse, error) {
+	return c.movieChart("/movie/top_rated", chartParams(page, region), "top rated movies")
+}
+
+// NowPlayingMovies lists movies in theatres; Dates holds the region's release window.
+func (c *Client) NowPlayingMovies(page int, region string) (*ReleaseWindowResponse, error) {
+	return c.movieChart("/movie/now_playing", chartParams(page, region), "now playing movies")
+}
+
+// UpcomingMovies lists upcoming releases; Dates holds the region's release window.
+func (c *Client) UpcomingMovies(page int, region string) (*ReleaseWindowResponse, error) {
+	return c.movieChart("/movie/upcoming", chartParams(page, region), "upcoming movies")
+}
+
+func (c *Client) PopularTV(page int) (*SearchTVResponse, error) {
+	return c.tvChart("/tv/popular", chartParams(page, ""), "popular TV")
+}
+
+func (c *Client) TopRatedTV(page int) (*SearchTVResponse, error) {
+	return c.tvChart("/tv/top_rated", chartParams(page, ""), "top rated TV")
+}
+
+func (c *Client) AiringTodayTV(page int) (*SearchTVResponse, error) {
+	return c.tvChart("/tv/airing_today", chartParams(page, ""), "TV airing today")
+}
+
+func (c *Client) OnTheAirTV(page int) (*SearchTVResponse, error) {
+	return c.tvChart("/tv/on_the_air", chartParams(page, ""), "TV on the air")
+}
+
+func (c *Client) PopularPeople(page int) (*SearchPersonResponse, error) {
+	return c.personChart("/person/popular", chartParams(page, ""), "popular people")
+}
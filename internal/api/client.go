@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 )
 
 const baseURL = "https://api.themoviedb.org/3"
@@ -16,6 +17,7 @@ type Client struct {
 	sessionID       string
 	accountID       int
 	accountObjectID string
+	userToken       string
 	http            *http.Client
 }
 
@@ -29,6 +31,26 @@ func New(token, sessionID string, accountID int, accountObjectID string) *Client
 	}
 }
 
+// SetHTTPClient replaces the HTTP client requests are sent with, e.g. to add
+// a timeout or to serve canned responses in tests.
+func (c *Client) SetHTTPClient(h *http.Client) {
+	c.http = h
+}
+
+// SetUserAccessToken sets the V4 user access token used for V4 requests.
+// Editing lists needs it; the read access token only allows reading.
+func (c *Client) SetUserAccessToken(token string) {
+	c.userToken = token
+}
+
+// v4Token returns the user access token when there is one.
+func (c *Client) v4Token() string {
+	if c.userToken != "" {
+		return c.userToken
+	}
+	return c.token
+}
+
 func newGetRequest(u, token string) (*http.Request, error) {
 	req, err := http.NewRequest("GET", u, nil)
 	if err != nil {
@@ -64,6 +86,36 @@ func (c *Client) get(path string, params url.Values) (json.RawMessage, error) {
 	return doRequest(c.http, req)
 }
 
+// pageParams asks for one page of a paged list; page 0 leaves it to TMDB.
+func pageParams(page int) url.Values {
+	if page <= 0 {
+		return nil
+	}
+	return url.Values{"page": {strconv.Itoa(page)}}
+}
+
+// allPages fetches every page of a paged list at path.
+func allPages[T any](c *Client, path, what string) ([]T, error) {
+	var all []T
+	for page := 1; ; page++ {
+		data, err := c.get(path, pageParams(page))
+		if err != nil {
+			return nil, fmt.Errorf("getting %s: %w", what, err)
+		}
+		var resp struct {
+			Results    []T `json:"results"`
+			TotalPages int `json:"total_pages"`
+		}
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return nil, err
+		}
+		all = append(all, resp.Results...)
+		if page >= resp.TotalPages {
+			return all, nil
+		}
+	}
+}
+
 func (c *Client) post(path string, payload any) (json.RawMessage, error) {
 	data, err := json.Marshal(payload)
 	if err != nil {
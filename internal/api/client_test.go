@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -180,3 +181,582 @@ func TestCombinedCreditsResponse(t *testing.T) {
 		t.Errorf("cast[1].MediaType = %q", resp.Cast[1].MediaType)
 	}
 }
+
+func TestDiscoverFilterParams(t *testing.T) {
+	f := DiscoverFilter{
+		Genres:           []int{18},
+		YearFrom:         1990,
+		YearTo:           1999,
+		VoteAverageMin:   7,
+		OriginalLanguage: "fi",
+		WatchProviders:   []int{8, 337},
+		People:           []int{287},
+		Certification:    "K-12",
+	}
+
+	movie := f.Params("movie")
+	want := map[string]string{
+		"with_genres":              "18",
+		"primary_release_date.gte": "1990-01-01",
+		"primary_release_date.lte": "1999-12-31",
+		"vote_average.gte":         "7",
+		"with_original_language":   "fi",
+		"with_watch_providers":     "8|337",
+		"with_people":              "287",
+		"certification":            "K-12",
+		"sort_by":                  "popularity.desc",
+	}
+	for k, v := range want {
+		if got := movie.Get(k); got != v {
+			t.Errorf("movie %s = %q, want %q", k, got, v)
+		}
+	}
+
+	tv := f.Params("tv")
+	if tv.Get("first_air_date.gte") != "1990-01-01" {
+		t.Errorf("tv first_air_date.gte = %q", tv.Get("first_air_date.gte"))
+	}
+	if tv.Has("with_people") || tv.Has("certification") {
+		t.Errorf("tv params should not include movie-only filters: %v", tv)
+	}
+}
+
+func TestReleaseWindowResponse(t *testing.T) {
+	raw := `{"dates":{"maximum":"2026-10-20","minimum":"2026-09-02"},"page":1,"total_pages":3,"results":[{"id":1,"title":"New Movie"}]}`
+	var resp ReleaseWindowResponse
+	if err := json.Unmarshal([]byte(raw), &resp); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if resp.Dates == nil || resp.Dates.Minimum != "2026-09-02" {
+		t.Errorf("Dates = %+v", resp.Dates)
+	}
+	if resp.TotalPages != 3 || len(resp.Results) != 1 || resp.Results[0].Title != "New Movie" {
+		t.Errorf("embedded response not decoded: %+v", resp.SearchMoviesResponse)
+	}
+
+	var popular ReleaseWindowResponse
+	if err := json.Unmarshal([]byte(`{"page":1,"results":[]}`), &popular); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if popular.Dates != nil {
+		t.Errorf("expected nil Dates for list without window, got %+v", popular.Dates)
+	}
+}
+
+func TestSearchMultiResponse(t *testing.T) {
+	raw := `{"page":1,"results":[
+		{"id":1396,"media_type":"tv","name":"Breaking Bad","first_air_date":"2008-01-20","vote_average":8.9},
+		{"id":603,"media_type":"movie","title":"The Matrix","release_date":"1999-03-24"},
+		{"id":287,"media_type":"person","name":"Brad Pitt","known_for_department":"Acting"}
+	]}`
+	var resp SearchMultiResponse
+	if err := json.Unmarshal([]byte(raw), &resp); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if len(resp.Results) != 3 {
+		t.Fatalf("got %d results, want 3", len(resp.Results))
+	}
+	if r := resp.Results[0]; r.TV == nil || r.TV.Name != "Breaking Bad" || r.Movie != nil {
+		t.Errorf("results[0] = %+v, want TV Breaking Bad", r)
+	}
+	if r := resp.Results[1]; r.Movie == nil || r.Movie.ID != 603 {
+		t.Errorf("results[1] = %+v, want movie 603", r)
+	}
+	if r := resp.Results[2]; r.Person == nil || r.Person.KnownForDepartment != "Acting" {
+		t.Errorf("results[2] = %+v, want person", r)
+	}
+
+	data, err := json.Marshal(resp.Results[1])
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	var flat map[string]any
+	json.Unmarshal(data, &flat)
+	if flat["media_type"] != "movie" || flat["title"] != "The Matrix" {
+		t.Errorf("marshalled = %s", data)
+	}
+}
+
+func TestSearchOptionsParams(t *testing.T) {
+	opts := SearchOptions{Year: 2021, FirstAirDateYear: 2008, Region: "FI", Language: "fi", IncludeAdult: true, Page: 2}
+
+	movie := opts.params("Dune", "movie")
+	if movie.Get("query") != "Dune" || movie.Get("year") != "2021" || movie.Get("region") != "FI" {
+		t.Errorf("movie params = %v", movie)
+	}
+	if movie.Has("first_air_date_year") {
+		t.Errorf("movie params should not include first_air_date_year: %v", movie)
+	}
+
+	tv := opts.params("Breaking Bad", "tv")
+	if tv.Get("first_air_date_year") != "2008" || tv.Has("region") {
+		t.Errorf("tv params = %v", tv)
+	}
+
+	person := opts.params("Brad Pitt", "person")
+	if person.Has("year") || person.Get("include_adult") != "true" || person.Get("page") != "2" || person.Get("language") != "fi" {
+		t.Errorf("person params = %v", person)
+	}
+
+	if empty := (SearchOptions{}).params("x", "movie"); len(empty) != 1 {
+		t.Errorf("zero options should only send query, got %v", empty)
+	}
+}
+
+func TestCollectionPartsOrder(t *testing.T) {
+	raw := `{"id":2344,"name":"The Matrix Collection","parts":[
+		{"id":624860,"title":"The Matrix Resurrections","release_date":"2021-12-16"},
+		{"id":999,"title":"Untitled","release_date":""},
+		{"id":603,"title":"The Matrix","release_date":"1999-03-31"},
+		{"id":604,"title":"The Matrix Reloaded","release_date":"2003-05-15"}
+	]}`
+	var c CollectionDetails
+	if err := json.Unmarshal([]byte(raw), &c); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	sortParts(c.Parts)
+	want := []int{603, 604, 624860, 999}
+	for i, id := range want {
+		if c.Parts[i].ID != id {
+			t.Errorf("parts[%d] = %d, want %d", i, c.Parts[i].ID, id)
+		}
+	}
+}
+
+func TestIsIMDbID(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"tt0133093", true},
+		{"nm0000093", true},
+		{"603", false},
+		{"tt", false},
+		{"ttabc", false},
+		{"xx0133093", false},
+	}
+	for _, tt := range tests {
+		if got := IsIMDbID(tt.in); got != tt.want {
+			t.Errorf("IsIMDbID(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFindResponse(t *testing.T) {
+	raw := `{"movie_results":[{"id":603,"title":"The Matrix"}],"person_results":[],"tv_results":[],
+		"tv_episode_results":[{"id":62161,"show_id":1396,"season_number":5,"episode_number":16,"name":"Felina"}],
+		"tv_season_results":[]}`
+	var resp FindResponse
+	if err := json.Unmarshal([]byte(raw), &resp); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if len(resp.MovieResults) != 1 || resp.MovieResults[0].ID != 603 {
+		t.Errorf("MovieResults = %+v", resp.MovieResults)
+	}
+	if len(resp.TVEpisodeResults) != 1 || resp.TVEpisodeResults[0].ShowID != 1396 {
+		t.Errorf("TVEpisodeResults = %+v", resp.TVEpisodeResults)
+	}
+}
+
+func TestWatchProvidersResponse(t *testing.T) {
+	raw := `{"id":603,"results":{"FI":{"link":"https://www.themoviedb.org/movie/603/watch?locale=FI",
+		"flatrate":[{"provider_id":8,"provider_name":"Netflix","display_priority":1}],
+		"free":[{"provider_id":323,"provider_name":"Yle Areena"}],
+		"buy":[{"provider_id":2,"provider_name":"Apple TV"}]}}}`
+	var resp WatchProvidersResponse
+	if err := json.Unmarshal([]byte(raw), &resp); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	fi := resp.Results["FI"]
+	if len(fi.Buy) != 1 || fi.Buy[0].ProviderName != "Apple TV" {
+		t.Errorf("Buy = %+v", fi.Buy)
+	}
+	streaming := fi.Streaming()
+	if len(streaming) != 2 || streaming[0].ProviderID != 8 || streaming[1].ProviderID != 323 {
+		t.Errorf("Streaming() = %+v", streaming)
+	}
+	if missing := resp.Results["SE"].Streaming(); len(missing) != 0 {
+		t.Errorf("missing region should have no providers, got %+v", missing)
+	}
+}
+
+func TestMovieCertification(t *testing.T) {
+	raw := `{"id":603,"title":"The Matrix","release_dates":{"results":[
+		{"iso_3166_1":"FI","release_dates":[
+			{"certification":"","release_date":"1999-06-11T00:00:00.000Z","type":1},
+			{"certification":"K-16","release_date":"1999-06-11T00:00:00.000Z","type":3},
+			{"certification":"K-12","release_date":"2009-01-01T00:00:00.000Z","type":4}
+		]},
+		{"iso_3166_1":"SE","release_dates":[{"certification":"15","release_date":"1999-06-11T00:00:00.000Z","type":4}]}
+	]}}`
+	var m MovieFullDetails
+	if err := json.Unmarshal([]byte(raw), &m); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if got := m.Certification("FI"); got != "K-16" {
+		t.Errorf("Certification(FI) = %q, want K-16 (theatrical)", got)
+	}
+	if got := m.Certification("SE"); got != "15" {
+		t.Errorf("Certification(SE) = %q, want 15 (fallback)", got)
+	}
+	if got := m.Certification("US"); got != "" {
+		t.Errorf("Certification(US) = %q, want empty", got)
+	}
+	if n := len(m.ReleaseDates.ForRegion("FI")); n != 3 {
+		t.Errorf("ForRegion(FI) returned %d dates, want 3", n)
+	}
+
+	var bare MovieFullDetails
+	if got := bare.Certification("FI"); got != "" {
+		t.Errorf("Certification without release dates = %q", got)
+	}
+}
+
+func TestTVContentRating(t *testing.T) {
+	raw := `{"id":1396,"name":"Breaking Bad","content_ratings":{"results":[{"iso_3166_1":"FI","rating":"K-16"}]}}`
+	var tv TVDetails
+	if err := json.Unmarshal([]byte(raw), &tv); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if got := tv.ContentRating("FI"); got != "K-16" {
+		t.Errorf("ContentRating(FI) = %q, want K-16", got)
+	}
+	if got := tv.ContentRating("US"); got != "" {
+		t.Errorf("ContentRating(US) = %q, want empty", got)
+	}
+}
+
+func TestVideoURL(t *testing.T) {
+	if got := (Video{Site: "YouTube", Key: "vKQi3bBA1y8"}).URL(); got != "https://www.youtube.com/watch?v=vKQi3bBA1y8" {
+		t.Errorf("YouTube URL = %q", got)
+	}
+	if got := (Video{Site: "Vimeo", Key: "12345"}).URL(); got != "https://vimeo.com/12345" {
+		t.Errorf("Vimeo URL = %q", got)
+	}
+	if got := (Video{Site: "Other", Key: "x"}).URL(); got != "" {
+		t.Errorf("unknown site URL = %q, want empty", got)
+	}
+}
+
+func TestBestTrailer(t *testing.T) {
+	videos := []Video{
+		{Key: "clip", Site: "YouTube", Type: "Clip", Official: true, Size: 1080},
+		{Key: "teaser", Site: "YouTube", Type: "Teaser", Official: true, Size: 1080},
+		{Key: "fan", Site: "YouTube", Type: "Trailer", Official: false, Size: 2160},
+		{Key: "late", Site: "YouTube", Type: "Trailer", Official: true, Size: 1080, PublishedAt: "2021-01-01"},
+		{Key: "main", Site: "YouTube", Type: "Trailer", Official: true, Size: 1080, PublishedAt: "1999-01-01"},
+		{Key: "other", Site: "Other", Type: "Trailer", Official: true, Size: 2160},
+	}
+	best := BestTrailer(videos)
+	if best == nil || best.Key != "main" {
+		t.Errorf("BestTrailer = %+v, want main", best)
+	}
+	if BestTrailer(videos[:1]) != nil {
+		t.Error("BestTrailer of clips only should be nil")
+	}
+}
+
+func TestImageConfigURL(t *testing.T) {
+	ic := DefaultImageConfig()
+	if got := ic.URL("/poster.jpg", "w500"); got != "https://image.tmdb.org/t/p/w500/poster.jpg" {
+		t.Errorf("URL = %q", got)
+	}
+	if got := ic.URL("", "w500"); got != "" {
+		t.Errorf("URL with empty path = %q, want empty", got)
+	}
+	if got := ic.Size("poster", "w500"); got != "w500" {
+		t.Errorf("Size(poster, w500) = %q", got)
+	}
+	if got := ic.Size("backdrop", "w500"); got != "original" {
+		t.Errorf("Size(backdrop, w500) = %q, want original", got)
+	}
+}
+
+func TestReviewsResponse(t *testing.T) {
+	raw := `{"id":603,"page":1,"total_pages":1,"results":[
+		{"id":"5b0e","author":"critic","author_details":{"username":"critic","rating":8.0},"content":"Great.","created_at":"2018-05-30T12:00:00.000Z"},
+		{"id":"5c1f","author":"anon","author_details":{"username":"anon","rating":null},"content":"Fine."}
+	]}`
+	var resp ReviewsResponse
+	if err := json.Unmarshal([]byte(raw), &resp); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if len(resp.Results) != 2 {
+		t.Fatalf("got %d reviews, want 2", len(resp.Results))
+	}
+	if r := resp.Results[0].AuthorDetails.Rating; r == nil || *r != 8.0 {
+		t.Errorf("rating = %v, want 8", r)
+	}
+	if resp.Results[1].AuthorDetails.Rating != nil {
+		t.Error("expected nil rating for unrated review")
+	}
+}
+
+func TestAggregateCredits(t *testing.T) {
+	raw := `{"id":1396,
+		"cast":[{"id":17419,"name":"Bryan Cranston","order":0,"total_episode_count":62,
+			"roles":[{"credit_id":"x","character":"Walter White","episode_count":62}]}],
+		"crew":[{"id":66633,"name":"Vince Gilligan","department":"Writing","total_episode_count":13,
+			"jobs":[{"credit_id":"y","job":"Writer","episode_count":13}]}]}`
+	var resp AggregateCredits
+	if err := json.Unmarshal([]byte(raw), &resp); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if len(resp.Cast) != 1 || resp.Cast[0].Roles[0].Character != "Walter White" || resp.Cast[0].TotalEpisodeCount != 62 {
+		t.Errorf("unexpected cast: %+v", resp.Cast)
+	}
+	if len(resp.Crew) != 1 || resp.Crew[0].Department != "Writing" || resp.Crew[0].Jobs[0].EpisodeCount != 13 {
+		t.Errorf("unexpected crew: %+v", resp.Crew)
+	}
+}
+
+func TestLocalTitles(t *testing.T) {
+	raw := `{"title":"The Matrix",
+		"alternative_titles":{"titles":[{"iso_3166_1":"FI","title":"Matrix","type":""},{"iso_3166_1":"DE","title":"Matrix","type":""}]},
+		"translations":{"translations":[{"iso_3166_1":"FI","iso_639_1":"fi","data":{"title":"Matrix"}},{"iso_3166_1":"SE","iso_639_1":"sv","data":{"title":"Matrix"}}]},
+		"keywords":{"keywords":[{"id":310,"name":"artificial intelligence"}]}}`
+	var m MovieFullDetails
+	if err := json.Unmarshal([]byte(raw), &m); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if got := LocalTitles(m.Title, m.AlternativeTitles.List(), m.Translations.List(), "FI"); len(got) != 1 || got[0] != "Matrix" {
+		t.Errorf("LocalTitles(FI) = %v, want [Matrix]", got)
+	}
+	if got := LocalTitles(m.Title, m.AlternativeTitles.List(), m.Translations.List(), "US"); len(got) != 0 {
+		t.Errorf("LocalTitles(US) = %v, want none", got)
+	}
+	if kw := m.Keywords.List(); len(kw) != 1 || kw[0].ID != 310 {
+		t.Errorf("keywords = %v", kw)
+	}
+
+	var tv TVDetails
+	if err := json.Unmarshal([]byte(`{"keywords":{"results":[{"id":1,"name":"drug"}]},"alternative_titles":{"results":[{"iso_3166_1":"FI","title":"Local title"}]}}`), &tv); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if len(tv.Keywords.List()) != 1 || len(tv.AlternativeTitles.List()) != 1 {
+		t.Errorf("TV keywords/titles not decoded from results: %+v", tv)
+	}
+}
+
+func TestEpisodeGroupMapping(t *testing.T) {
+	raw := `{"id":"g1","name":"DVD Order","type":3,"groups":[
+		{"id":"b","name":"Volume 2","order":2,"episodes":[
+			{"id":30,"show_id":99,"season_number":2,"episode_number":1,"order":0}]},
+		{"id":"a","name":"Volume 1","order":1,"episodes":[
+			{"id":12,"show_id":99,"season_number":1,"episode_number":3,"order":1},
+			{"id":11,"show_id":99,"season_number":1,"episode_number":1,"order":0}]}]}`
+	var d EpisodeGroupDetails
+	if err := json.Unmarshal([]byte(raw), &d); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	d.sort()
+
+	e, err := d.Episode(1, 2)
+	if err != nil {
+		t.Fatalf("Episode(1, 2) error: %v", err)
+	}
+	if e.SeasonNumber != 1 || e.EpisodeNumber != 3 || e.ShowID != 99 {
+		t.Errorf("Episode(1, 2) = S%02dE%02d of %d, want S01E03 of 99", e.SeasonNumber, e.EpisodeNumber, e.ShowID)
+	}
+	if e, _ := d.Episode(2, 1); e == nil || e.ID != 30 {
+		t.Errorf("Episode(2, 1) = %+v, want episode 30", e)
+	}
+	if _, err := d.Episode(3, 1); err == nil {
+		t.Error("expected error for missing season")
+	}
+	if _, err := d.Episode(1, 5); err == nil {
+		t.Error("expected error for missing episode")
+	}
+	if got := d.ShowID(); got != 99 {
+		t.Errorf("ShowID() = %d, want 99", got)
+	}
+}
+
+func TestListDetails(t *testing.T) {
+	raw := `{"id":8291,"name":"Heists","public":true,"page":1,"total_pages":1,"total_results":2,
+		"comments":{"movie:949":"Start here","tv:1396":null},
+		"results":[{"media_type":"movie","id":949,"title":"Heat"},{"media_type":"tv","id":1396,"name":"Breaking Bad"}]}`
+	var l ListDetails
+	if err := json.Unmarshal([]byte(raw), &l); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if len(l.Results) != 2 || l.Results[0].Key() != "movie:949" || l.Results[1].Key() != "tv:1396" {
+		t.Fatalf("unexpected results: %+v", l.Results)
+	}
+	if got := l.Comments[l.Results[0].Key()]; got != "Start here" {
+		t.Errorf("comment = %q, want %q", got, "Start here")
+	}
+}
+
+func TestListItemsFailed(t *testing.T) {
+	resp := ListItemsResponse{Results: []ListItemResult{
+		{MediaType: "movie", MediaID: 949, Success: true},
+		{MediaType: "tv", MediaID: 1, Success: false},
+	}}
+	failed := resp.Failed()
+	if len(failed) != 1 || failed[0] != "tv:1" {
+		t.Errorf("Failed() = %v, want [tv:1]", failed)
+	}
+}
+
+func TestChangeItemSummary(t *testing.T) {
+	raw := `{"changes":[
+		{"key":"release_dates","items":[{"action":"added","time":"2026-10-10 08:00:00 UTC","iso_3166_1":"FI",
+			"value":{"certification":"","release_date":"2026-11-20T00:00:00.000Z","type":4}}]},
+		{"key":"season","items":[{"action":"added","value":{"season_id":123,"season_number":3}}]},
+		{"key":"title","items":[{"action":"updated","value":"New Title","original_value":"Old Title"}]},
+		{"key":"images","items":[{"action":"deleted","original_value":{"poster":{"file_path":"/x.jpg"}}}]}
+	]}`
+	var resp ChangesResponse
+	if err := json.Unmarshal([]byte(raw), &resp); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	want := []string{"Digital 2026-11-20", "season 3", "New Title", `{"poster":{"file_path":"/x.jpg"}}`}
+	for i, c := range resp.Changes {
+		if got := c.Items[0].Summary(); got != want[i] {
+			t.Errorf("%s summary = %q, want %q", c.Key, got, want[i])
+		}
+	}
+}
+
+func TestTruncateValue(t *testing.T) {
+	long := strings.Repeat("ä", 61)
+	if got, want := truncateValue(long), strings.Repeat("ä", 60)+"…"; got != want {
+		t.Errorf("truncateValue = %q, want %q", got, want)
+	}
+	if got := truncateValue("short"); got != "short" {
+		t.Errorf("truncateValue(short) = %q", got)
+	}
+}
+
+func TestTrimRef(t *testing.T) {
+	tests := []struct{ in, kind, want string }{
+		{"[review:5b1c]", "review", "5b1c"},
+		{"review:5b1c", "review", "5b1c"},
+		{"5b1c", "review", "5b1c"},
+		{"[list:8291]", "list", "8291"},
+	}
+	for _, tt := range tests {
+		if got := TrimRef(tt.in, tt.kind); got != tt.want {
+			t.Errorf("TrimRef(%q, %q) = %q, want %q", tt.in, tt.kind, got, tt.want)
+		}
+	}
+}
+
+func TestParseMediaRef(t *testing.T) {
+	tests := []struct {
+		in   string
+		want MediaRef
+	}{
+		{"603", MediaRef{ID: 603}},
+		{"movie:603", MediaRef{Kind: "movie", ID: 603}},
+		{"[movie:603]", MediaRef{Kind: "movie", ID: 603}},
+		{"tv:1396/S05", MediaRef{Kind: "tv", ID: 1396, HasSeason: true, Season: 5}},
+		{"tv:1396/S05E16", MediaRef{Kind: "tv", ID: 1396, HasSeason: true, Season: 5, Episode: 16}},
+		{"tt0133093", MediaRef{IMDbID: "tt0133093"}},
+		{"https://www.themoviedb.org/movie/603-the-matrix", MediaRef{Kind: "movie", ID: 603}},
+		{"themoviedb.org/tv/1396-breaking-bad/season/5/episode/16", MediaRef{Kind: "tv", ID: 1396, HasSeason: true, Season: 5, Episode: 16}},
+	}
+	for _, tt := range tests {
+		got, err := ParseMediaRef(tt.in)
+		if err != nil {
+			t.Errorf("ParseMediaRef(%q) error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseMediaRef(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+	for _, in := range []string{"", "film:603", "movie:603/S01", "tv:1396/X5", "matrix"} {
+		if _, err := ParseMediaRef(in); err == nil {
+			t.Errorf("ParseMediaRef(%q) expected error", in)
+		}
+	}
+}
+
+func TestMediaRefString(t *testing.T) {
+	tests := []struct {
+		ref  MediaRef
+		want string
+	}{
+		{MediaRef{Kind: "movie", ID: 603}, "movie:603"},
+		{MediaRef{Kind: "tv", ID: 1396, HasSeason: true, Season: 5}, "tv:1396/S05"},
+		{MediaRef{Kind: "tv", ID: 1396, HasSeason: true, Season: 5, Episode: 16}, "tv:1396/S05E16"},
+		{MediaRef{ID: 603}, "603"},
+	}
+	for _, tt := range tests {
+		if got := tt.ref.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestParseTitleQuery(t *testing.T) {
+	tests := []struct {
+		in    string
+		title string
+		year  int
+	}{
+		{"The Matrix (1999)", "The Matrix", 1999},
+		{"  Dune  ", "Dune", 0},
+		{"Blade Runner 2049", "Blade Runner 2049", 0},
+		{"(1999)", "(1999)", 0},
+	}
+	for _, tt := range tests {
+		title, year := ParseTitleQuery(tt.in)
+		if title != tt.title || year != tt.year {
+			t.Errorf("ParseTitleQuery(%q) = %q, %d, want %q, %d", tt.in, title, year, tt.title, tt.year)
+		}
+	}
+}
+
+func TestBestMatch(t *testing.T) {
+	movie := func(id int, title, date string, pop float64) MultiResult {
+		return MultiResult{MediaType: "movie", Movie: &MovieResult{ID: id, Title: title, ReleaseDate: date, Popularity: pop}}
+	}
+	tests := []struct {
+		name   string
+		cands  []MultiResult
+		title  string
+		year   int
+		wantID int // 0 when ambiguous
+	}{
+		{"single exact", []MultiResult{movie(1, "Heat", "1995-12-15", 1)}, "heat", 0, 1},
+		{"single inexact", []MultiResult{movie(1, "Heat Wave", "1990-01-01", 1)}, "heat", 0, 0},
+		{"only exact title", []MultiResult{movie(2, "The Matrix Reloaded", "2003-05-15", 50), movie(603, "The Matrix", "1999-03-31", 40)}, "the matrix", 0, 603},
+		{"popular exact titles", []MultiResult{movie(841, "Dune", "1984-12-14", 40), movie(438631, "Dune", "2021-09-15", 300)}, "Dune", 0, 438631},
+		{"similarly popular exact titles", []MultiResult{movie(1, "Heat", "1995-12-15", 30), movie(2, "Heat", "1986-03-14", 20)}, "Heat", 0, 0},
+		{"exact title and year", []MultiResult{movie(438631, "Dune", "2021-09-15", 300), movie(841, "Dune", "1984-12-14", 40)}, "Dune", 1984, 841},
+		{"exact title, other year", []MultiResult{movie(603, "The Matrix", "1999-03-31", 40)}, "The Matrix", 2021, 0},
+		{"close without exact", []MultiResult{movie(30, "Heat Wave", "", 10), movie(31, "White Heat", "", 9)}, "heat", 0, 0},
+	}
+	for _, tt := range tests {
+		got, ok := BestMatch(tt.cands, tt.title, tt.year)
+		switch {
+		case tt.wantID == 0 && ok:
+			t.Errorf("%s: BestMatch picked %s, want ambiguous", tt.name, got.Key())
+		case tt.wantID != 0 && (!ok || got.Ref().ID != tt.wantID):
+			t.Errorf("%s: BestMatch = %s, %v, want movie:%d", tt.name, got.Key(), ok, tt.wantID)
+		}
+	}
+}
+
+func TestAppendParams(t *testing.T) {
+	if got := appendParams("external_ids", nil).Get("append_to_response"); got != "external_ids" {
+		t.Errorf("without extras = %q", got)
+	}
+	want := "external_ids,keywords,alternative_titles,translations"
+	if got := appendParams("external_ids", InfoExtras).Get("append_to_response"); got != want {
+		t.Errorf("with InfoExtras = %q, want %q", got, want)
+	}
+}
+
+func TestListItemCommentSendsEmpty(t *testing.T) {
+	data, err := json.Marshal(listItemComment(ListItem{MediaType: "movie", MediaID: 603}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"media_type":"movie","media_id":603,"comment":""}`; string(data) != want {
+		t.Errorf("payload = %s, want %s", data, want)
+	}
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+func (c *Client) SearchCollections(query string, opts SearchOptions) (*SearchCollectionsResponse, error) {
+	data, err := c.get("/search/collection", opts.params(query, "collection"))
+	if err != nil {
+		return nil, fmt.Errorf("searching collections: %w", err)
+	}
+	var resp SearchCollectionsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) SearchCompanies(query string, opts SearchOptions) (*SearchCompaniesResponse, error) {
+	data, err := c.get("/search/company", opts.params(query, "company"))
+	if err != nil {
+		return nil, fmt.Errorf("searching companies: %w", err)
+	}
+	var resp SearchCompaniesResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CollectionDetails returns a collection with its parts sorted by release date.
+// Unreleased parts without a date are listed last.
+func (c *Client) CollectionDetails(collectionID int) (*CollectionDetails, error) {
+	data, err := c.get(fmt.Sprintf("/collection/%d", collectionID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting collection: %w", err)
+	}
+	var resp CollectionDetails
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	sortParts(resp.Parts)
+	return &resp, nil
+}
+
+// sortParts orders movies by release date, undated ones last.
+func sortParts(parts []MovieResult) {
+	sort.SliceStable(parts, func(i, j int) bool {
+		a, b := parts[i].ReleaseDate, parts[j].ReleaseDate
+		if a == "" || b == "" {
+			return b == "" && a != ""
+		}
+		return a < b
+	})
+}
+
+func (c *Client) CompanyDetails(companyID int) (*CompanyDetails, error) {
+	data, err := c.get(fmt.Sprintf("/company/%d", companyID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting company: %w", err)
+	}
+	var resp CompanyDetails
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) NetworkDetails(networkID int) (*NetworkDetails, error) {
+	data, err := c.get(fmt.Sprintf("/network/%d", networkID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting network: %w", err)
+	}
+	var resp NetworkDetails
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) KeywordDetails(keywordID int) (*Keyword, error) {
+	data, err := c.get(fmt.Sprintf("/keyword/%d", keywordID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting keyword: %w", err)
+	}
+	var resp Keyword
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// KeywordMovies lists movies tagged with a keyword, most popular first.
+func (c *Client) KeywordMovies(keywordID, page int) (*SearchMoviesResponse, error) {
+	return c.DiscoverMovies(DiscoverFilter{Keywords: []int{keywordID}, Page: page})
+}
+
+// CompanyMovies lists movies produced by a company, most popular first.
+func (c *Client) CompanyMovies(companyID, page int) (*SearchMoviesResponse, error) {
+	return c.DiscoverMovies(DiscoverFilter{Companies: []int{companyID}, Page: page})
+}
+
+// NetworkTV lists shows aired on a network, most popular first.
+func (c *Client) NetworkTV(networkID, page int) (*SearchTVResponse, error) {
+	return c.DiscoverTV(DiscoverFilter{Networks: []int{networkID}, Page: page})
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func (c *Client) credits(path, what string) (*MovieCredits, error) {
+	data, err := c.get(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting %s credits: %w", what, err)
+	}
+	var resp MovieCredits
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) MovieCredits(movieID int) (*MovieCredits, error) {
+	return c.credits(fmt.Sprintf("/movie/%d/credits", movieID), "movie")
+}
+
+// SeasonCredits returns the cast and crew of a single season.
+func (c *Client) SeasonCredits(seriesID, season int) (*MovieCredits, error) {
+	return c.credits(fmt.Sprintf("/tv/%d/season/%d/credits", seriesID, season), "season")
+}
+
+// AggregateCredits returns everyone who worked on a series across all
+// seasons, with the episode count of each role or job.
+func (c *Client) AggregateCredits(seriesID int) (*AggregateCredits, error) {
+	data, err := c.get(fmt.Sprintf("/tv/%d/aggregate_credits", seriesID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting TV credits: %w", err)
+	}
+	var resp AggregateCredits
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -4,11 +4,23 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
 )
 
-func (c *Client) GetMovieInfo(movieID int) (*MovieFullDetails, error) {
+// InfoExtras are the extra parts the info command appends to movie and TV
+// details: keywords and the titles the entry is released under.
+var InfoExtras = []string{"keywords", "alternative_titles", "translations"}
+
+// appendParams asks for base and any extras as append_to_response.
+func appendParams(base string, extras []string) url.Values {
+	return url.Values{"append_to_response": {strings.Join(append([]string{base}, extras...), ",")}}
+}
+
+// GetMovieInfo returns movie details with credits, external IDs and release
+// dates, plus any extras (see InfoExtras).
+func (c *Client) GetMovieInfo(movieID int, extras ...string) (*MovieFullDetails, error) {
 	path := fmt.Sprintf("/movie/%d", movieID)
-	params := url.Values{"append_to_response": {"credits"}}
+	params := appendParams("credits,external_ids,release_dates", extras)
 	data, err := c.get(path, params)
 	if err != nil {
 		return nil, fmt.Errorf("getting movie info: %w", err)
@@ -33,9 +45,12 @@ func (c *Client) Filmography(personID int) (*CombinedCreditsResponse, error) {
 	return &resp, nil
 }
 
-func (c *Client) TVDetails(seriesID int) (*TVDetails, error) {
+// TVDetails returns series details with external IDs and content ratings,
+// plus any extras (see InfoExtras).
+func (c *Client) TVDetails(seriesID int, extras ...string) (*TVDetails, error) {
 	path := fmt.Sprintf("/tv/%d", seriesID)
-	data, err := c.get(path, nil)
+	params := appendParams("external_ids,content_ratings", extras)
+	data, err := c.get(path, params)
 	if err != nil {
 		return nil, fmt.Errorf("getting TV details: %w", err)
 	}
@@ -59,6 +74,34 @@ func (c *Client) SeasonDetails(seriesID, seasonNumber int) (*SeasonDetails, erro
 	return &resp, nil
 }
 
+func (c *Client) EpisodeDetails(seriesID, season, episode int) (*TVEpisode, error) {
+	path := fmt.Sprintf("/tv/%d/season/%d/episode/%d", seriesID, season, episode)
+	params := url.Values{"append_to_response": {"external_ids"}}
+	data, err := c.get(path, params)
+	if err != nil {
+		return nil, fmt.Errorf("getting episode details: %w", err)
+	}
+	var resp TVEpisode
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) PersonDetails(personID int) (*PersonDetails, error) {
+	path := fmt.Sprintf("/person/%d", personID)
+	params := url.Values{"append_to_response": {"external_ids"}}
+	data, err := c.get(path, params)
+	if err != nil {
+		return nil, fmt.Errorf("getting person details: %w", err)
+	}
+	var resp PersonDetails
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 func (c *Client) GetRatedMovies() (*SearchMoviesResponse, error) {
 	path := fmt.Sprintf("/account/%d/rated/movies", c.accountID)
 	data, err := c.get(path, nil)
@@ -110,3 +153,23 @@ func (c *Client) GetWatchlistTV() (*SearchTVResponse, error) {
 	}
 	return &resp, nil
 }
+
+// GetFavoriteMovies fetches all pages of the account's favorite movies.
+func (c *Client) GetFavoriteMovies() ([]MovieResult, error) {
+	return allPages[MovieResult](c, fmt.Sprintf("/account/%d/favorite/movies", c.accountID), "favorite movies")
+}
+
+// GetFavoriteTV fetches all pages of the account's favorite TV shows.
+func (c *Client) GetFavoriteTV() ([]TVResult, error) {
+	return allPages[TVResult](c, fmt.Sprintf("/account/%d/favorite/tv", c.accountID), "favorite TV")
+}
+
+// GetAllWatchlistMovies fetches all pages of the movie watchlist.
+func (c *Client) GetAllWatchlistMovies() ([]MovieResult, error) {
+	return allPages[MovieResult](c, fmt.Sprintf("/account/%d/watchlist/movies", c.accountID), "movie watchlist")
+}
+
+// GetAllWatchlistTV fetches all pages of the TV watchlist.
+func (c *Client) GetAllWatchlistTV() ([]TVResult, error) {
+	return allPages[TVResult](c, fmt.Sprintf("/account/%d/watchlist/tv", c.accountID), "TV watchlist")
+}
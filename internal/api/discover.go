@@ -0,0 +1,141 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// DiscoverFilter describes a /discover query. Zero values are omitted.
+// Genres, companies, keywords and people are ANDed; watch providers are ORed.
+type DiscoverFilter struct {
+	Genres               []int
+	WithoutGenres        []int
+	YearFrom             int
+	YearTo               int
+	VoteAverageMin       float64
+	VoteAverageMax       float64
+	VoteCountMin         int
+	RuntimeMin           int
+	RuntimeMax           int
+	OriginalLanguage     string
+	Certification        string // movies only
+	CertificationCountry string // movies only
+	WatchProviders       []int
+	WatchRegion          string
+	Companies            []int
+	Keywords             []int
+	People               []int // movies only
+	Networks             []int // tv only
+	SortBy               string
+	Page                 int
+}
+
+// Params builds the query parameters for the given media type ("movie" or "tv").
+func (f DiscoverFilter) Params(mediaType string) url.Values {
+	params := url.Values{}
+	dateField := "primary_release_date"
+	if mediaType == "tv" {
+		dateField = "first_air_date"
+	}
+	setIDs(params, "with_genres", f.Genres, ",")
+	setIDs(params, "without_genres", f.WithoutGenres, ",")
+	if f.YearFrom > 0 {
+		params.Set(dateField+".gte", fmt.Sprintf("%04d-01-01", f.YearFrom))
+	}
+	if f.YearTo > 0 {
+		params.Set(dateField+".lte", fmt.Sprintf("%04d-12-31", f.YearTo))
+	}
+	if f.VoteAverageMin > 0 {
+		params.Set("vote_average.gte", strconv.FormatFloat(f.VoteAverageMin, 'f', -1, 64))
+	}
+	if f.VoteAverageMax > 0 {
+		params.Set("vote_average.lte", strconv.FormatFloat(f.VoteAverageMax, 'f', -1, 64))
+	}
+	if f.VoteCountMin > 0 {
+		params.Set("vote_count.gte", strconv.Itoa(f.VoteCountMin))
+	}
+	if f.RuntimeMin > 0 {
+		params.Set("with_runtime.gte", strconv.Itoa(f.RuntimeMin))
+	}
+	if f.RuntimeMax > 0 {
+		params.Set("with_runtime.lte", strconv.Itoa(f.RuntimeMax))
+	}
+	if f.OriginalLanguage != "" {
+		params.Set("with_original_language", f.OriginalLanguage)
+	}
+	if mediaType != "tv" && f.Certification != "" {
+		params.Set("certification", f.Certification)
+		params.Set("certification_country", f.CertificationCountry)
+	}
+	setIDs(params, "with_watch_providers", f.WatchProviders, "|")
+	if f.WatchRegion != "" {
+		params.Set("watch_region", f.WatchRegion)
+	}
+	setIDs(params, "with_companies", f.Companies, ",")
+	setIDs(params, "with_keywords", f.Keywords, ",")
+	if mediaType == "tv" {
+		setIDs(params, "with_networks", f.Networks, ",")
+	} else {
+		setIDs(params, "with_people", f.People, ",")
+	}
+	sortBy := f.SortBy
+	if sortBy == "" {
+		sortBy = "popularity.desc"
+	}
+	params.Set("sort_by", sortBy)
+	if f.Page > 0 {
+		params.Set("page", strconv.Itoa(f.Page))
+	}
+	return params
+}
+
+func setIDs(params url.Values, key string, ids []int, sep string) {
+	if len(ids) == 0 {
+		return
+	}
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.Itoa(id)
+	}
+	params.Set(key, strings.Join(parts, sep))
+}
+
+func (c *Client) DiscoverMovies(f DiscoverFilter) (*SearchMoviesResponse, error) {
+	data, err := c.get("/discover/movie", f.Params("movie"))
+	if err != nil {
+		return nil, fmt.Errorf("discovering movies: %w", err)
+	}
+	var resp SearchMoviesResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) DiscoverTV(f DiscoverFilter) (*SearchTVResponse, error) {
+	data, err := c.get("/discover/tv", f.Params("tv"))
+	if err != nil {
+		return nil, fmt.Errorf("discovering TV: %w", err)
+	}
+	var resp SearchTVResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Genres returns the official genre list for "movie" or "tv".
+func (c *Client) Genres(mediaType string) ([]Genre, error) {
+	data, err := c.get(fmt.Sprintf("/genre/%s/list", mediaType), nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting genres: %w", err)
+	}
+	var resp GenreListResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Genres, nil
+}
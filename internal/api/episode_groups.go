@@ -0,0 +1,97 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+var episodeGroupTypes = map[int]string{
+	1: "Original air date",
+	2: "Absolute",
+	3: "DVD",
+	4: "Digital",
+	5: "Story arc",
+	6: "Production",
+	7: "TV",
+}
+
+// EpisodeGroupTypeName returns a readable name for an episode group type.
+func EpisodeGroupTypeName(t int) string {
+	if name, ok := episodeGroupTypes[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("Type %d", t)
+}
+
+// EpisodeGroups lists the alternate orderings (DVD, absolute, story arc...)
+// available for a series.
+func (c *Client) EpisodeGroups(seriesID int) ([]EpisodeGroup, error) {
+	data, err := c.get(fmt.Sprintf("/tv/%d/episode_groups", seriesID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting episode groups: %w", err)
+	}
+	var resp EpisodeGroupsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// EpisodeGroupDetails returns an episode group with its groups and episodes
+// sorted by their order in the group.
+func (c *Client) EpisodeGroupDetails(groupID string) (*EpisodeGroupDetails, error) {
+	data, err := c.get("/tv/episode_group/"+url.PathEscape(groupID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting episode group: %w", err)
+	}
+	var resp EpisodeGroupDetails
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	resp.sort()
+	return &resp, nil
+}
+
+func (d *EpisodeGroupDetails) sort() {
+	sort.SliceStable(d.Groups, func(i, j int) bool { return d.Groups[i].Order < d.Groups[j].Order })
+	for _, g := range d.Groups {
+		sort.SliceStable(g.Episodes, func(i, j int) bool { return g.Episodes[i].Order < g.Episodes[j].Order })
+	}
+}
+
+// ShowID returns the series the ordering belongs to, taken from its
+// episodes, or 0 when it has none.
+func (d *EpisodeGroupDetails) ShowID() int {
+	for _, g := range d.Groups {
+		for _, e := range g.Episodes {
+			if e.ShowID != 0 {
+				return e.ShowID
+			}
+		}
+	}
+	return 0
+}
+
+// Season returns the nth group of the ordering (1-based), so S01 in the
+// ordering is the first group whatever its order value.
+func (d *EpisodeGroupDetails) Season(n int) (*EpisodeGroupSeason, error) {
+	if n < 1 || n > len(d.Groups) {
+		return nil, fmt.Errorf("%s has no season %d (it has %d)", d.Name, n, len(d.Groups))
+	}
+	return &d.Groups[n-1], nil
+}
+
+// Episode maps season and episode in the ordering (both 1-based) to the
+// canonical TMDB episode.
+func (d *EpisodeGroupDetails) Episode(season, episode int) (*GroupEpisode, error) {
+	s, err := d.Season(season)
+	if err != nil {
+		return nil, err
+	}
+	if episode < 1 || episode > len(s.Episodes) {
+		return nil, fmt.Errorf("%s has no episode %d (it has %d)", s.Name, episode, len(s.Episodes))
+	}
+	return &s.Episodes[episode-1], nil
+}
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func (c *Client) externalIDs(path, what string) (*ExternalIDs, error) {
+	data, err := c.get(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting %s external IDs: %w", what, err)
+	}
+	var resp ExternalIDs
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) MovieExternalIDs(movieID int) (*ExternalIDs, error) {
+	return c.externalIDs(fmt.Sprintf("/movie/%d/external_ids", movieID), "movie")
+}
+
+func (c *Client) TVExternalIDs(seriesID int) (*ExternalIDs, error) {
+	return c.externalIDs(fmt.Sprintf("/tv/%d/external_ids", seriesID), "TV")
+}
+
+func (c *Client) EpisodeExternalIDs(seriesID, season, episode int) (*ExternalIDs, error) {
+	path := fmt.Sprintf("/tv/%d/season/%d/episode/%d/external_ids", seriesID, season, episode)
+	return c.externalIDs(path, "episode")
+}
+
+func (c *Client) PersonExternalIDs(personID int) (*ExternalIDs, error) {
+	return c.externalIDs(fmt.Sprintf("/person/%d/external_ids", personID), "person")
+}
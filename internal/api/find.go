@@ -0,0 +1,51 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ExternalSources maps short source names to TMDB's external_source values.
+var ExternalSources = map[string]string{
+	"imdb":      "imdb_id",
+	"tvdb":      "tvdb_id",
+	"wikidata":  "wikidata_id",
+	"facebook":  "facebook_id",
+	"instagram": "instagram_id",
+	"twitter":   "twitter_id",
+	"tiktok":    "tiktok_id",
+	"youtube":   "youtube_id",
+}
+
+// IsIMDbID reports whether s looks like an IMDb title or person ID (tt123, nm123).
+func IsIMDbID(s string) bool {
+	if len(s) < 3 || !(strings.HasPrefix(s, "tt") || strings.HasPrefix(s, "nm")) {
+		return false
+	}
+	for _, c := range s[2:] {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// FindByExternalID looks up TMDB entries by an external ID. source is either a
+// short name from ExternalSources or a raw external_source value.
+func (c *Client) FindByExternalID(source, externalID string) (*FindResponse, error) {
+	if s, ok := ExternalSources[source]; ok {
+		source = s
+	}
+	params := url.Values{"external_source": {source}}
+	data, err := c.get("/find/"+url.PathEscape(externalID), params)
+	if err != nil {
+		return nil, fmt.Errorf("finding %s: %w", externalID, err)
+	}
+	var resp FindResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+)
+
+const defaultImageBaseURL = "https://image.tmdb.org/t/p/"
+
+// DefaultImageConfig is used until /configuration has been fetched.
+func DefaultImageConfig() ImageConfig {
+	return ImageConfig{
+		SecureBaseURL: defaultImageBaseURL,
+		PosterSizes:   []string{"w92", "w154", "w185", "w342", "w500", "w780", "original"},
+		BackdropSizes: []string{"w300", "w780", "w1280", "original"},
+		LogoSizes:     []string{"w45", "w92", "w154", "w185", "w300", "w500", "original"},
+		ProfileSizes:  []string{"w45", "w185", "h632", "original"},
+		StillSizes:    []string{"w92", "w185", "w300", "original"},
+	}
+}
+
+// URL builds the full image URL for a file path at the given size.
+func (ic ImageConfig) URL(filePath, size string) string {
+	if filePath == "" {
+		return ""
+	}
+	if size == "" {
+		size = "original"
+	}
+	return ic.SecureBaseURL + size + filePath
+}
+
+// Sizes returns the available sizes for an image kind: poster, backdrop,
+// logo, profile or still.
+func (ic ImageConfig) Sizes(kind string) []string {
+	switch kind {
+	case "poster":
+		return ic.PosterSizes
+	case "backdrop":
+		return ic.BackdropSizes
+	case "logo":
+		return ic.LogoSizes
+	case "profile":
+		return ic.ProfileSizes
+	case "still":
+		return ic.StillSizes
+	}
+	return nil
+}
+
+// Size returns preferred if it is a valid size for kind, otherwise "original".
+func (ic ImageConfig) Size(kind, preferred string) string {
+	if slices.Contains(ic.Sizes(kind), preferred) {
+		return preferred
+	}
+	return "original"
+}
+
+// TypedImage is an image tagged with its kind (poster, backdrop, logo,
+// profile or still).
+type TypedImage struct {
+	Kind string `json:"kind"`
+	Image
+}
+
+// All returns every image in the response tagged with its kind.
+func (r *ImagesResponse) All() []TypedImage {
+	var all []TypedImage
+	groups := []struct {
+		kind   string
+		images []Image
+	}{
+		{"poster", r.Posters},
+		{"backdrop", r.Backdrops},
+		{"logo", r.Logos},
+		{"profile", r.Profiles},
+		{"still", r.Stills},
+	}
+	for _, g := range groups {
+		for _, img := range g.images {
+			all = append(all, TypedImage{g.kind, img})
+		}
+	}
+	return all
+}
+
+func (c *Client) Configuration() (*ConfigurationResponse, error) {
+	data, err := c.get("/configuration", nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting configuration: %w", err)
+	}
+	var resp ConfigurationResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) images(path, what string) (*ImagesResponse, error) {
+	data, err := c.get(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting %s images: %w", what, err)
+	}
+	var resp ImagesResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) MovieImages(movieID int) (*ImagesResponse, error) {
+	return c.images(fmt.Sprintf("/movie/%d/images", movieID), "movie")
+}
+
+func (c *Client) TVImages(seriesID int) (*ImagesResponse, error) {
+	return c.images(fmt.Sprintf("/tv/%d/images", seriesID), "TV")
+}
+
+func (c *Client) SeasonImages(seriesID, season int) (*ImagesResponse, error) {
+	return c.images(fmt.Sprintf("/tv/%d/season/%d/images", seriesID, season), "season")
+}
+
+func (c *Client) EpisodeImages(seriesID, season, episode int) (*ImagesResponse, error) {
+	path := fmt.Sprintf("/tv/%d/season/%d/episode/%d/images", seriesID, season, episode)
+	return c.images(path, "episode")
+}
+
+// DownloadImage fetches an image file from the image CDN.
+func (c *Client) DownloadImage(imageURL string) ([]byte, error) {
+	resp, err := c.http.Get(imageURL)
+	if err != nil {
+		return nil, fmt.Errorf("downloading image: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("downloading image: HTTP %d", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func (c *Client) PersonImages(personID int) (*ImagesResponse, error) {
+	return c.images(fmt.Sprintf("/person/%d/images", personID), "person")
+}
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// sendV4 makes a write request with a JSON body to the V4 API. A nil payload
+// sends no body.
+func (c *Client) sendV4(method, path string, payload any) (json.RawMessage, error) {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, baseURLv4+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.v4Token())
+	req.Header.Set("Content-Type", "application/json;charset=utf-8")
+	req.Header.Set("Accept", "application/json")
+	return doRequest(c.http, req)
+}
+
+// ListOptions are the editable properties of a list. Zero values are left
+// out, so an update only changes what is set.
+type ListOptions struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Language    string `json:"iso_639_1,omitempty"`
+	Public      *bool  `json:"public,omitempty"`
+	SortBy      string `json:"sort_by,omitempty"`
+}
+
+// CreateList creates a list and returns its ID. The language defaults to en.
+func (c *Client) CreateList(opts ListOptions) (int, error) {
+	if opts.Language == "" {
+		opts.Language = "en"
+	}
+	data, err := c.sendV4("POST", "/list", opts)
+	if err != nil {
+		return 0, fmt.Errorf("creating list: %w", err)
+	}
+	var resp struct {
+		ID int `json:"id"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return 0, err
+	}
+	return resp.ID, nil
+}
+
+func (c *Client) UpdateList(listID int, opts ListOptions) error {
+	if _, err := c.sendV4("PUT", fmt.Sprintf("/list/%d", listID), opts); err != nil {
+		return fmt.Errorf("updating list: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) DeleteList(listID int) error {
+	if _, err := c.sendV4("DELETE", fmt.Sprintf("/list/%d", listID), nil); err != nil {
+		return fmt.Errorf("deleting list: %w", err)
+	}
+	return nil
+}
+
+// ClearList removes every item from a list but keeps the list.
+func (c *Client) ClearList(listID int) error {
+	if _, err := c.getV4(fmt.Sprintf("/list/%d/clear", listID), nil); err != nil {
+		return fmt.Errorf("clearing list: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) GetList(listID, page int) (*ListDetails, error) {
+	data, err := c.getV4(fmt.Sprintf("/list/%d", listID), pageParams(page))
+	if err != nil {
+		return nil, fmt.Errorf("getting list: %w", err)
+	}
+	var resp ListDetails
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// MyLists returns the lists created by the logged-in account.
+func (c *Client) MyLists(page int) (*ListsResponse, error) {
+	data, err := c.getV4(fmt.Sprintf("/account/%s/lists", c.accountObjectID), pageParams(page))
+	if err != nil {
+		return nil, fmt.Errorf("getting lists: %w", err)
+	}
+	var resp ListsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// AddListItems adds movies and TV shows to a list, with their comments.
+func (c *Client) AddListItems(listID int, items []ListItem) error {
+	return c.listItems("POST", listID, items, "adding list items")
+}
+
+// UpdateListItems changes the comments of items already on a list. An empty
+// comment clears it.
+func (c *Client) UpdateListItems(listID int, items []ListItem) error {
+	comments := make([]listItemComment, len(items))
+	for i, item := range items {
+		comments[i] = listItemComment(item)
+	}
+	return c.listItems("PUT", listID, comments, "updating list items")
+}
+
+// listItemComment is a ListItem that always sends its comment, even "".
+type listItemComment struct {
+	MediaType string `json:"media_type"`
+	MediaID   int    `json:"media_id"`
+	Comment   string `json:"comment"`
+}
+
+func (c *Client) RemoveListItems(listID int, items []ListItem) error {
+	return c.listItems("DELETE", listID, items, "removing list items")
+}
+
+func (c *Client) listItems(method string, listID int, items any, what string) error {
+	payload := map[string]any{"items": items}
+	data, err := c.sendV4(method, fmt.Sprintf("/list/%d/items", listID), payload)
+	if err != nil {
+		return fmt.Errorf("%s: %w", what, err)
+	}
+	var resp ListItemsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+	if failed := resp.Failed(); len(failed) > 0 {
+		return fmt.Errorf("%s: failed for %s", what, strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// Failed returns the items the API did not accept, as "movie:603".
+func (r *ListItemsResponse) Failed() []string {
+	var failed []string
+	for _, item := range r.Results {
+		if !item.Success {
+			failed = append(failed, fmt.Sprintf("%s:%d", item.MediaType, item.MediaID))
+		}
+	}
+	return failed
+}
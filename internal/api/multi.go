@@ -0,0 +1,46 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SearchMulti searches movies, TV and people at once. TMDB ignores year and
+// region filters for multi search.
+func (c *Client) SearchMulti(query string, opts SearchOptions) (*SearchMultiResponse, error) {
+	data, err := c.get("/search/multi", opts.params(query, "multi"))
+	if err != nil {
+		return nil, fmt.Errorf("searching: %w", err)
+	}
+	var resp SearchMultiResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// TrendingAll lists trending movies, shows and people together.
+func (c *Client) TrendingAll(window string, page int) (*SearchMultiResponse, error) {
+	data, err := c.get("/trending/all/"+window, chartParams(page, ""))
+	if err != nil {
+		return nil, fmt.Errorf("getting trending: %w", err)
+	}
+	var resp SearchMultiResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Key returns the result as "movie:603", the form list comments are keyed by.
+func (r MultiResult) Key() string {
+	switch {
+	case r.Movie != nil:
+		return fmt.Sprintf("movie:%d", r.Movie.ID)
+	case r.TV != nil:
+		return fmt.Sprintf("tv:%d", r.TV.ID)
+	case r.Person != nil:
+		return fmt.Sprintf("person:%d", r.Person.ID)
+	}
+	return ""
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+func (c *Client) watchProviders(path, what string) (*WatchProvidersResponse, error) {
+	data, err := c.get(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting %s watch providers: %w", what, err)
+	}
+	var resp WatchProvidersResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// MovieWatchProviders returns where a movie can be watched, keyed by region.
+func (c *Client) MovieWatchProviders(movieID int) (*WatchProvidersResponse, error) {
+	return c.watchProviders(fmt.Sprintf("/movie/%d/watch/providers", movieID), "movie")
+}
+
+// TVWatchProviders returns where a show can be watched, keyed by region.
+func (c *Client) TVWatchProviders(seriesID int) (*WatchProvidersResponse, error) {
+	return c.watchProviders(fmt.Sprintf("/tv/%d/watch/providers", seriesID), "TV")
+}
+
+// ProviderCatalogue lists all watch providers for "movie" or "tv", optionally
+// limited to a region.
+func (c *Client) ProviderCatalogue(mediaType, region string) ([]WatchProvider, error) {
+	var params url.Values
+	if region != "" {
+		params = url.Values{"watch_region": {region}}
+	}
+	data, err := c.get("/watch/providers/"+mediaType, params)
+	if err != nil {
+		return nil, fmt.Errorf("getting watch providers: %w", err)
+	}
+	var resp ProviderListResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// WatchRegions lists the regions TMDB has watch provider data for.
+func (c *Client) WatchRegions() ([]WatchRegion, error) {
+	data, err := c.get("/watch/providers/regions", nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting watch regions: %w", err)
+	}
+	var resp WatchRegionsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
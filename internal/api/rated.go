@@ -21,6 +21,8 @@ type RatedMovie struct {
 	Overview       string        `json:"overview"`
 	VoteAverage    float64       `json:"vote_average"`
 	AccountRating  AccountRating `json:"account_rating"`
+	PosterPath     string        `json:"poster_path,omitempty"`
+	PosterURL      string        `json:"poster_url,omitempty"`
 }
 
 type RatedTV struct {
@@ -30,6 +32,8 @@ type RatedTV struct {
 	Overview       string        `json:"overview"`
 	VoteAverage    float64       `json:"vote_average"`
 	AccountRating  AccountRating `json:"account_rating"`
+	PosterPath     string        `json:"poster_path,omitempty"`
+	PosterURL      string        `json:"poster_url,omitempty"`
 }
 
 type RatedMoviesResponse struct {
@@ -52,7 +56,7 @@ func (c *Client) getV4(path string, params url.Values) (json.RawMessage, error)
 	if params != nil {
 		u += "?" + params.Encode()
 	}
-	req, err := newGetRequest(u, c.token)
+	req, err := newGetRequest(u, c.v4Token())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func (c *Client) MovieRecommendations(movieID, page int) (*SearchMoviesResponse, error) {
+	path := fmt.Sprintf("/movie/%d/recommendations", movieID)
+	data, err := c.get(path, pageParams(page))
+	if err != nil {
+		return nil, fmt.Errorf("getting movie recommendations: %w", err)
+	}
+	var resp SearchMoviesResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) SimilarMovies(movieID, page int) (*SearchMoviesResponse, error) {
+	path := fmt.Sprintf("/movie/%d/similar", movieID)
+	data, err := c.get(path, pageParams(page))
+	if err != nil {
+		return nil, fmt.Errorf("getting similar movies: %w", err)
+	}
+	var resp SearchMoviesResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) TVRecommendations(seriesID, page int) (*SearchTVResponse, error) {
+	path := fmt.Sprintf("/tv/%d/recommendations", seriesID)
+	data, err := c.get(path, pageParams(page))
+	if err != nil {
+		return nil, fmt.Errorf("getting TV recommendations: %w", err)
+	}
+	var resp SearchTVResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) SimilarTV(seriesID, page int) (*SearchTVResponse, error) {
+	path := fmt.Sprintf("/tv/%d/similar", seriesID)
+	data, err := c.get(path, pageParams(page))
+	if err != nil {
+		return nil, fmt.Errorf("getting similar TV: %w", err)
+	}
+	var resp SearchTVResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// RefKinds are the kinds of TMDB entries a MediaRef can point to.
+var RefKinds = []string{"movie", "tv", "person", "collection", "company", "network", "keyword"}
+
+// MediaRef points to a TMDB entry, or to a season or episode of a TV show.
+// Kind is empty when the input didn't say (a bare ID or an IMDb ID), and
+// IMDbID is set instead of ID until it has been looked up.
+type MediaRef struct {
+	Kind      string
+	ID        int
+	IMDbID    string
+	HasSeason bool
+	Season    int
+	Episode   int // 0 for a whole season
+}
+
+// String formats the reference the way output tags entries, so it can be
+// pasted back as input: movie:603, tv:1396/S05E16.
+func (r MediaRef) String() string {
+	var s string
+	switch {
+	case r.IMDbID != "":
+		s = r.IMDbID
+	case r.Kind == "":
+		s = strconv.Itoa(r.ID)
+	default:
+		s = fmt.Sprintf("%s:%d", r.Kind, r.ID)
+	}
+	if r.HasSeason {
+		s += fmt.Sprintf("/S%02d", r.Season)
+		if r.Episode > 0 {
+			s += fmt.Sprintf("E%02d", r.Episode)
+		}
+	}
+	return s
+}
+
+func isRefKind(s string) bool {
+	for _, k := range RefKinds {
+		if s == k {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	seasonCodeRe = regexp.MustCompile(`(?i)^S(\d+)(?:E(\d+))?$`)
+	// leadingIDRe matches the ID in TMDB URL slugs such as 603-the-matrix.
+	leadingIDRe = regexp.MustCompile(`^(\d+)(?:-.*)?$`)
+)
+
+// TrimRef strips the brackets and "kind:" prefix that listings print around
+// IDs other than media references, as in [review:5b1c...] or [list:8291].
+func TrimRef(s, kind string) string {
+	s = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(s), "["), "]")
+	return strings.TrimPrefix(s, kind+":")
+}
+
+// ParseMediaRef parses the forms entries can be referred to by:
+//
+//	603                          bare TMDB ID (kind from context)
+//	movie:603, [movie:603]       typed ID, as printed in listings
+//	tv:1396/S05, tv:1396/S05E16  a season or episode
+//	tt0133093, nm0000093         IMDb IDs
+//	https://www.themoviedb.org/tv/1396-breaking-bad/season/5/episode/16
+func ParseMediaRef(s string) (MediaRef, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "["), "]")
+	if s == "" {
+		return MediaRef{}, fmt.Errorf("empty reference")
+	}
+	if IsIMDbID(s) {
+		return MediaRef{IMDbID: s}, nil
+	}
+	if strings.Contains(s, "themoviedb.org/") {
+		return parseTMDBURL(s)
+	}
+
+	var ref MediaRef
+	rest := s
+	if kind, after, ok := strings.Cut(s, ":"); ok {
+		if !isRefKind(kind) {
+			return MediaRef{}, fmt.Errorf("unknown type %q in %q (use %s)", kind, s, strings.Join(RefKinds, ", "))
+		}
+		ref.Kind, rest = kind, after
+	}
+	idPart, code, hasCode := strings.Cut(rest, "/")
+	if IsIMDbID(idPart) {
+		ref.IMDbID = idPart
+	} else {
+		id, err := strconv.Atoi(idPart)
+		if err != nil || id <= 0 {
+			return MediaRef{}, fmt.Errorf("invalid reference %q (use an ID, movie:603, tv:1396/S05E16, a TMDB URL or an IMDb ID)", s)
+		}
+		ref.ID = id
+	}
+	if hasCode {
+		if ref.Kind != "tv" {
+			return MediaRef{}, fmt.Errorf("seasons and episodes need a tv reference, got %q", s)
+		}
+		m := seasonCodeRe.FindStringSubmatch(code)
+		if m == nil {
+			return MediaRef{}, fmt.Errorf("invalid season or episode %q in %q (use S05 or S05E16)", code, s)
+		}
+		ref.HasSeason = true
+		ref.Season, _ = strconv.Atoi(m[1])
+		if m[2] != "" {
+			ref.Episode, _ = strconv.Atoi(m[2])
+		}
+	}
+	return ref, nil
+}
+
+// parseTMDBURL reads the kind, ID and any season/episode from a TMDB website
+// URL path: /movie/603-the-matrix, /tv/1396/season/5/episode/16.
+func parseTMDBURL(s string) (MediaRef, error) {
+	if !strings.Contains(s, "://") {
+		s = "https://" + s
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return MediaRef{}, fmt.Errorf("invalid URL %q: %w", s, err)
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 2 || !isRefKind(parts[0]) {
+		return MediaRef{}, fmt.Errorf("unsupported TMDB URL %q", s)
+	}
+	m := leadingIDRe.FindStringSubmatch(parts[1])
+	if m == nil {
+		return MediaRef{}, fmt.Errorf("no ID in TMDB URL %q", s)
+	}
+	ref := MediaRef{Kind: parts[0]}
+	ref.ID, _ = strconv.Atoi(m[1])
+	if ref.Kind == "tv" && len(parts) >= 4 && parts[2] == "season" {
+		season, err := strconv.Atoi(parts[3])
+		if err != nil {
+			return MediaRef{}, fmt.Errorf("invalid season in TMDB URL %q", s)
+		}
+		ref.HasSeason, ref.Season = true, season
+		if len(parts) >= 6 && parts[4] == "episode" {
+			if ref.Episode, err = strconv.Atoi(parts[5]); err != nil {
+				return MediaRef{}, fmt.Errorf("invalid episode in TMDB URL %q", s)
+			}
+		}
+	}
+	return ref, nil
+}
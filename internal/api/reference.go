@@ -0,0 +1,49 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func (c *Client) reference(path, what string, v any) error {
+	data, err := c.get(path, nil)
+	if err != nil {
+		return fmt.Errorf("getting %s: %w", what, err)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Countries lists the ISO 3166-1 country codes TMDB uses.
+func (c *Client) Countries() ([]Country, error) {
+	var resp []Country
+	err := c.reference("/configuration/countries", "countries", &resp)
+	return resp, err
+}
+
+// Languages lists the ISO 639-1 language codes TMDB uses.
+func (c *Client) Languages() ([]Language, error) {
+	var resp []Language
+	err := c.reference("/configuration/languages", "languages", &resp)
+	return resp, err
+}
+
+func (c *Client) Timezones() ([]Timezones, error) {
+	var resp []Timezones
+	err := c.reference("/configuration/timezones", "timezones", &resp)
+	return resp, err
+}
+
+// Jobs lists crew departments and the jobs in each.
+func (c *Client) Jobs() ([]DepartmentJobs, error) {
+	var resp []DepartmentJobs
+	err := c.reference("/configuration/jobs", "jobs", &resp)
+	return resp, err
+}
+
+// PrimaryTranslations lists the language-region codes (fi-FI, pt-BR) TMDB
+// has translations for.
+func (c *Client) PrimaryTranslations() ([]string, error) {
+	var resp []string
+	err := c.reference("/configuration/primary_translations", "primary translations", &resp)
+	return resp, err
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Release types used by /movie/{id}/release_dates.
+const (
+	ReleasePremiere          = 1
+	ReleaseTheatricalLimited = 2
+	ReleaseTheatrical        = 3
+	ReleaseDigital           = 4
+	ReleasePhysical          = 5
+	ReleaseTV                = 6
+)
+
+var releaseTypeNames = map[int]string{
+	ReleasePremiere:          "Premiere",
+	ReleaseTheatricalLimited: "Theatrical (limited)",
+	ReleaseTheatrical:        "Theatrical",
+	ReleaseDigital:           "Digital",
+	ReleasePhysical:          "Physical",
+	ReleaseTV:                "TV",
+}
+
+// ReleaseTypeName returns the display name of a release type.
+func ReleaseTypeName(t int) string {
+	if name, ok := releaseTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("Type %d", t)
+}
+
+// ForRegion returns the release dates for a country code, or nil.
+func (r *ReleaseDatesResponse) ForRegion(region string) []ReleaseDate {
+	if r == nil {
+		return nil
+	}
+	for _, c := range r.Results {
+		if c.ISO31661 == region {
+			return c.ReleaseDates
+		}
+	}
+	return nil
+}
+
+// Certification returns the movie's age rating in region, preferring the
+// theatrical release's certification.
+func (m *MovieFullDetails) Certification(region string) string {
+	dates := m.ReleaseDates.ForRegion(region)
+	for _, d := range dates {
+		if d.Type == ReleaseTheatrical && d.Certification != "" {
+			return d.Certification
+		}
+	}
+	for _, d := range dates {
+		if d.Certification != "" {
+			return d.Certification
+		}
+	}
+	return ""
+}
+
+// ContentRating returns the show's age rating in region.
+func (t *TVDetails) ContentRating(region string) string {
+	if t.ContentRatings == nil {
+		return ""
+	}
+	for _, r := range t.ContentRatings.Results {
+		if r.ISO31661 == region {
+			return r.Rating
+		}
+	}
+	return ""
+}
+
+func (c *Client) MovieReleaseDates(movieID int) (*ReleaseDatesResponse, error) {
+	data, err := c.get(fmt.Sprintf("/movie/%d/release_dates", movieID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting release dates: %w", err)
+	}
+	var resp ReleaseDatesResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) TVContentRatings(seriesID int) (*ContentRatingsResponse, error) {
+	data, err := c.get(fmt.Sprintf("/tv/%d/content_ratings", seriesID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting content ratings: %w", err)
+	}
+	var resp ContentRatingsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Certifications returns the certification systems for "movie" or "tv", keyed
+// by country code.
+func (c *Client) Certifications(mediaType string) (map[string][]Certification, error) {
+	data, err := c.get(fmt.Sprintf("/certification/%s/list", mediaType), nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting certifications: %w", err)
+	}
+	var resp CertificationsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Certifications, nil
+}
@@ -0,0 +1,181 @@
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TitleKinds are the kinds ResolveTitle can search for.
+var TitleKinds = []string{"movie", "tv", "person"}
+
+// maxCandidates caps how many matches an AmbiguousError lists.
+const maxCandidates = 10
+
+// popularLead is how many times more popular than the runner-up an
+// exact-title match must be for BestMatch to pick it.
+const popularLead = 3
+
+var titleYearRe = regexp.MustCompile(`^(.*?)\s*\((\d{4})\)$`)
+
+// ParseTitleQuery splits "The Matrix (1999)" into its title and year. The
+// year is 0 when the query doesn't end in one.
+func ParseTitleQuery(s string) (string, int) {
+	s = strings.TrimSpace(s)
+	if m := titleYearRe.FindStringSubmatch(s); m != nil && m[1] != "" {
+		year, _ := strconv.Atoi(m[2])
+		return m[1], year
+	}
+	return s, 0
+}
+
+// AmbiguousError is returned by ResolveTitle when no single entry matches
+// exactly. Candidates are ordered by popularity.
+type AmbiguousError struct {
+	Query      string
+	Candidates []MultiResult
+}
+
+func (e *AmbiguousError) Error() string {
+	return fmt.Sprintf("%q matches %d titles; use one of these references", e.Query, len(e.Candidates))
+}
+
+// Ref returns the reference to the result's entry.
+func (r MultiResult) Ref() MediaRef {
+	switch {
+	case r.Movie != nil:
+		return MediaRef{Kind: "movie", ID: r.Movie.ID}
+	case r.TV != nil:
+		return MediaRef{Kind: "tv", ID: r.TV.ID}
+	case r.Person != nil:
+		return MediaRef{Kind: "person", ID: r.Person.ID}
+	}
+	return MediaRef{}
+}
+
+func (r MultiResult) name() string {
+	switch {
+	case r.Movie != nil:
+		return r.Movie.Title
+	case r.TV != nil:
+		return r.TV.Name
+	case r.Person != nil:
+		return r.Person.Name
+	}
+	return ""
+}
+
+// year returns the release or first air year, or 0 when unknown.
+func (r MultiResult) year() int {
+	var date string
+	switch {
+	case r.Movie != nil:
+		date = r.Movie.ReleaseDate
+	case r.TV != nil:
+		date = r.TV.FirstAirDate
+	}
+	if len(date) < 4 {
+		return 0
+	}
+	year, _ := strconv.Atoi(date[:4])
+	return year
+}
+
+func (r MultiResult) popularity() float64 {
+	switch {
+	case r.Movie != nil:
+		return r.Movie.Popularity
+	case r.TV != nil:
+		return r.TV.Popularity
+	case r.Person != nil:
+		return r.Person.Popularity
+	}
+	return 0
+}
+
+// TitleCandidates searches each kind for the query and returns the first
+// page of matches, most popular first. A year in the query ("Dune (2021)")
+// narrows movies by release year and shows by first air year.
+func (c *Client) TitleCandidates(query string, kinds ...string) ([]MultiResult, error) {
+	title, year := ParseTitleQuery(query)
+	var cands []MultiResult
+	for _, kind := range kinds {
+		switch kind {
+		case "movie":
+			resp, err := c.SearchMovies(title, SearchOptions{PrimaryReleaseYear: year})
+			if err != nil {
+				return nil, err
+			}
+			for i := range resp.Results {
+				cands = append(cands, MultiResult{MediaType: kind, Movie: &resp.Results[i]})
+			}
+		case "tv":
+			resp, err := c.SearchTV(title, SearchOptions{FirstAirDateYear: year})
+			if err != nil {
+				return nil, err
+			}
+			for i := range resp.Results {
+				cands = append(cands, MultiResult{MediaType: kind, TV: &resp.Results[i]})
+			}
+		case "person":
+			resp, err := c.SearchPerson(title, SearchOptions{})
+			if err != nil {
+				return nil, err
+			}
+			for i := range resp.Results {
+				cands = append(cands, MultiResult{MediaType: kind, Person: &resp.Results[i]})
+			}
+		}
+	}
+	sort.SliceStable(cands, func(i, j int) bool {
+		return cands[i].popularity() > cands[j].popularity()
+	})
+	return cands, nil
+}
+
+// BestMatch picks the candidate a title unambiguously refers to among those
+// whose title matches exactly (ignoring case) and, when year is not 0, that
+// came out that year: the only one, or one far more popular than the rest.
+func BestMatch(cands []MultiResult, title string, year int) (MultiResult, bool) {
+	var exact []MultiResult
+	for _, r := range cands {
+		if strings.EqualFold(strings.TrimSpace(r.name()), strings.TrimSpace(title)) && (year == 0 || r.year() == year) {
+			exact = append(exact, r)
+		}
+	}
+	switch {
+	case len(exact) == 0:
+		return MultiResult{}, false
+	case len(exact) > 1:
+		sort.SliceStable(exact, func(i, j int) bool {
+			return exact[i].popularity() > exact[j].popularity()
+		})
+		if exact[0].popularity() < popularLead*exact[1].popularity() {
+			return MultiResult{}, false
+		}
+	}
+	return exact[0], true
+}
+
+// ResolveTitle finds the entry a title such as "The Matrix (1999)" refers
+// to among the given kinds (see BestMatch). When no single entry matches
+// exactly it returns an *AmbiguousError listing the candidates.
+func (c *Client) ResolveTitle(query string, kinds ...string) (MediaRef, error) {
+	cands, err := c.TitleCandidates(query, kinds...)
+	if err != nil {
+		return MediaRef{}, err
+	}
+	if len(cands) == 0 {
+		return MediaRef{}, fmt.Errorf("no %s found for %q", strings.Join(kinds, " or "), query)
+	}
+	title, year := ParseTitleQuery(query)
+	if best, ok := BestMatch(cands, title, year); ok {
+		return best.Ref(), nil
+	}
+	if len(cands) > maxCandidates {
+		cands = cands[:maxCandidates]
+	}
+	return MediaRef{}, &AmbiguousError{Query: query, Candidates: cands}
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+func (c *Client) reviews(path string, page int, what string) (*ReviewsResponse, error) {
+	data, err := c.get(path, pageParams(page))
+	if err != nil {
+		return nil, fmt.Errorf("getting %s reviews: %w", what, err)
+	}
+	var resp ReviewsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) MovieReviews(movieID, page int) (*ReviewsResponse, error) {
+	return c.reviews(fmt.Sprintf("/movie/%d/reviews", movieID), page, "movie")
+}
+
+func (c *Client) TVReviews(seriesID, page int) (*ReviewsResponse, error) {
+	return c.reviews(fmt.Sprintf("/tv/%d/reviews", seriesID), page, "TV")
+}
+
+func (c *Client) Review(reviewID string) (*Review, error) {
+	data, err := c.get("/review/"+url.PathEscape(reviewID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting review: %w", err)
+	}
+	var resp Review
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
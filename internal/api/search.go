@@ -4,11 +4,51 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
 )
 
-func (c *Client) SearchMovies(query string) (*SearchMoviesResponse, error) {
+// SearchOptions narrows a search. Zero values are omitted; each endpoint only
+// sends the options TMDB supports for it.
+type SearchOptions struct {
+	Year               int // movie: any release year; tv: any season's air year
+	PrimaryReleaseYear int // movie only
+	FirstAirDateYear   int // tv only
+	IncludeAdult       bool
+	Region             string // movie only
+	Language           string
+	Page               int
+}
+
+func (o SearchOptions) params(query, mediaType string) url.Values {
 	params := url.Values{"query": {query}}
-	data, err := c.get("/search/movie", params)
+	setInt := func(key string, v int) {
+		if v > 0 {
+			params.Set(key, strconv.Itoa(v))
+		}
+	}
+	switch mediaType {
+	case "movie":
+		setInt("year", o.Year)
+		setInt("primary_release_year", o.PrimaryReleaseYear)
+		if o.Region != "" {
+			params.Set("region", o.Region)
+		}
+	case "tv":
+		setInt("year", o.Year)
+		setInt("first_air_date_year", o.FirstAirDateYear)
+	}
+	if o.IncludeAdult {
+		params.Set("include_adult", "true")
+	}
+	if o.Language != "" {
+		params.Set("language", o.Language)
+	}
+	setInt("page", o.Page)
+	return params
+}
+
+func (c *Client) SearchMovies(query string, opts SearchOptions) (*SearchMoviesResponse, error) {
+	data, err := c.get("/search/movie", opts.params(query, "movie"))
 	if err != nil {
 		return nil, fmt.Errorf("searching movies: %w", err)
 	}
@@ -19,9 +59,8 @@ func (c *Client) SearchMovies(query string) (*SearchMoviesResponse, error) {
 	return &resp, nil
 }
 
-func (c *Client) SearchTV(query string) (*SearchTVResponse, error) {
-	params := url.Values{"query": {query}}
-	data, err := c.get("/search/tv", params)
+func (c *Client) SearchTV(query string, opts SearchOptions) (*SearchTVResponse, error) {
+	data, err := c.get("/search/tv", opts.params(query, "tv"))
 	if err != nil {
 		return nil, fmt.Errorf("searching TV: %w", err)
 	}
@@ -32,9 +71,8 @@ func (c *Client) SearchTV(query string) (*SearchTVResponse, error) {
 	return &resp, nil
 }
 
-func (c *Client) SearchPerson(query string) (*SearchPersonResponse, error) {
-	params := url.Values{"query": {query}}
-	data, err := c.get("/search/person", params)
+func (c *Client) SearchPerson(query string, opts SearchOptions) (*SearchPersonResponse, error) {
+	data, err := c.get("/search/person", opts.params(query, "person"))
 	if err != nil {
 		return nil, fmt.Errorf("searching people: %w", err)
 	}
@@ -44,3 +82,15 @@ func (c *Client) SearchPerson(query string) (*SearchPersonResponse, error) {
 	}
 	return &resp, nil
 }
+
+func (c *Client) SearchKeywords(query string, opts SearchOptions) (*SearchKeywordsResponse, error) {
+	data, err := c.get("/search/keyword", opts.params(query, "keyword"))
+	if err != nil {
+		return nil, fmt.Errorf("searching keywords: %w", err)
+	}
+	var resp SearchKeywordsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
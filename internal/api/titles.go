@@ -0,0 +1,97 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+func (c *Client) titleKeywords(path, what string) ([]Keyword, error) {
+	data, err := c.get(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting %s keywords: %w", what, err)
+	}
+	var resp KeywordsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return resp.List(), nil
+}
+
+func (c *Client) MovieKeywords(movieID int) ([]Keyword, error) {
+	return c.titleKeywords(fmt.Sprintf("/movie/%d/keywords", movieID), "movie")
+}
+
+func (c *Client) TVKeywords(seriesID int) ([]Keyword, error) {
+	return c.titleKeywords(fmt.Sprintf("/tv/%d/keywords", seriesID), "TV")
+}
+
+func (c *Client) alternativeTitles(path, country, what string) ([]AlternativeTitle, error) {
+	params := url.Values{}
+	if country != "" {
+		params.Set("country", country)
+	}
+	data, err := c.get(path, params)
+	if err != nil {
+		return nil, fmt.Errorf("getting %s alternative titles: %w", what, err)
+	}
+	var resp AlternativeTitlesResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return resp.List(), nil
+}
+
+// MovieAlternativeTitles returns other titles a movie is known by, limited to
+// country (ISO 3166-1) unless it is empty.
+func (c *Client) MovieAlternativeTitles(movieID int, country string) ([]AlternativeTitle, error) {
+	return c.alternativeTitles(fmt.Sprintf("/movie/%d/alternative_titles", movieID), country, "movie")
+}
+
+func (c *Client) TVAlternativeTitles(seriesID int, country string) ([]AlternativeTitle, error) {
+	return c.alternativeTitles(fmt.Sprintf("/tv/%d/alternative_titles", seriesID), country, "TV")
+}
+
+func (c *Client) translations(path, what string) ([]Translation, error) {
+	data, err := c.get(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting %s translations: %w", what, err)
+	}
+	var resp TranslationsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return resp.List(), nil
+}
+
+func (c *Client) MovieTranslations(movieID int) ([]Translation, error) {
+	return c.translations(fmt.Sprintf("/movie/%d/translations", movieID), "movie")
+}
+
+func (c *Client) TVTranslations(seriesID int) ([]Translation, error) {
+	return c.translations(fmt.Sprintf("/tv/%d/translations", seriesID), "TV")
+}
+
+// LocalTitles returns the alternative titles and translated title used in
+// region, without duplicates or the original title itself.
+func LocalTitles(title string, alts []AlternativeTitle, translations []Translation, region string) []string {
+	seen := map[string]bool{title: true, "": true}
+	var titles []string
+	add := func(t string) {
+		if !seen[t] {
+			seen[t] = true
+			titles = append(titles, t)
+		}
+	}
+	for _, tr := range translations {
+		if tr.Country == region {
+			add(tr.Data.DisplayTitle())
+		}
+	}
+	for _, a := range alts {
+		if a.Country == region {
+			add(a.Title)
+		}
+	}
+	return titles
+}
@@ -1,5 +1,7 @@
 package api
 
+import "encoding/json"
+
 // Search results
 
 type MovieResult struct {
@@ -8,7 +10,10 @@ type MovieResult struct {
 	ReleaseDate string  `json:"release_date"`
 	Overview    string  `json:"overview"`
 	VoteAverage float64 `json:"vote_average"`
+	Popularity  float64 `json:"popularity,omitempty"`
 	Rating      float64 `json:"rating,omitempty"` // user's rating (from rated lists)
+	PosterPath  string  `json:"poster_path,omitempty"`
+	PosterURL   string  `json:"poster_url,omitempty"` // filled in for JSON output
 }
 
 type TVResult struct {
@@ -17,13 +22,17 @@ type TVResult struct {
 	FirstAirDate string  `json:"first_air_date"`
 	Overview     string  `json:"overview"`
 	VoteAverage  float64 `json:"vote_average"`
+	Popularity   float64 `json:"popularity,omitempty"`
 	Rating       float64 `json:"rating,omitempty"`
+	PosterPath   string  `json:"poster_path,omitempty"`
+	PosterURL    string  `json:"poster_url,omitempty"`
 }
 
 type PersonResult struct {
-	ID                 int    `json:"id"`
-	Name               string `json:"name"`
-	KnownForDepartment string `json:"known_for_department"`
+	ID                 int     `json:"id"`
+	Name               string  `json:"name"`
+	KnownForDepartment string  `json:"known_for_department"`
+	Popularity         float64 `json:"popularity,omitempty"`
 }
 
 type SearchMoviesResponse struct {
@@ -47,6 +56,550 @@ type SearchPersonResponse struct {
 	TotalResults int            `json:"total_results"`
 }
 
+// MultiResult is a single /search/multi or /trending/all entry. Exactly one of
+// Movie, TV or Person is set, according to MediaType.
+type MultiResult struct {
+	MediaType string
+	Movie     *MovieResult
+	TV        *TVResult
+	Person    *PersonResult
+}
+
+func (r *MultiResult) UnmarshalJSON(data []byte) error {
+	var head struct {
+		MediaType string `json:"media_type"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return err
+	}
+	*r = MultiResult{MediaType: head.MediaType}
+	switch head.MediaType {
+	case "movie":
+		r.Movie = &MovieResult{}
+		return json.Unmarshal(data, r.Movie)
+	case "tv":
+		r.TV = &TVResult{}
+		return json.Unmarshal(data, r.TV)
+	case "person":
+		r.Person = &PersonResult{}
+		return json.Unmarshal(data, r.Person)
+	}
+	return nil
+}
+
+func (r MultiResult) MarshalJSON() ([]byte, error) {
+	switch {
+	case r.Movie != nil:
+		return json.Marshal(struct {
+			MediaType string `json:"media_type"`
+			*MovieResult
+		}{r.MediaType, r.Movie})
+	case r.TV != nil:
+		return json.Marshal(struct {
+			MediaType string `json:"media_type"`
+			*TVResult
+		}{r.MediaType, r.TV})
+	case r.Person != nil:
+		return json.Marshal(struct {
+			MediaType string `json:"media_type"`
+			*PersonResult
+		}{r.MediaType, r.Person})
+	}
+	return json.Marshal(map[string]string{"media_type": r.MediaType})
+}
+
+type SearchMultiResponse struct {
+	Page         int           `json:"page"`
+	Results      []MultiResult `json:"results"`
+	TotalPages   int           `json:"total_pages"`
+	TotalResults int           `json:"total_results"`
+}
+
+// Charts
+
+type DateRange struct {
+	Minimum string `json:"minimum"`
+	Maximum string `json:"maximum"`
+}
+
+// ReleaseWindowResponse is a movie list that may carry the release window
+// (now playing and upcoming lists only).
+type ReleaseWindowResponse struct {
+	SearchMoviesResponse
+	Dates *DateRange `json:"dates,omitempty"`
+}
+
+// Genres and keywords
+
+type Genre struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type GenreListResponse struct {
+	Genres []Genre `json:"genres"`
+}
+
+type Keyword struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// KeywordsResponse holds a title's keywords: movies return them under
+// "keywords", TV shows under "results".
+type KeywordsResponse struct {
+	Keywords []Keyword `json:"keywords,omitempty"`
+	Results  []Keyword `json:"results,omitempty"`
+}
+
+func (r *KeywordsResponse) List() []Keyword {
+	if r == nil {
+		return nil
+	}
+	if len(r.Keywords) > 0 {
+		return r.Keywords
+	}
+	return r.Results
+}
+
+type AlternativeTitle struct {
+	Country string `json:"iso_3166_1"`
+	Title   string `json:"title"`
+	Type    string `json:"type"`
+}
+
+// AlternativeTitlesResponse holds alternative titles: movies return them under
+// "titles", TV shows under "results".
+type AlternativeTitlesResponse struct {
+	Titles  []AlternativeTitle `json:"titles,omitempty"`
+	Results []AlternativeTitle `json:"results,omitempty"`
+}
+
+func (r *AlternativeTitlesResponse) List() []AlternativeTitle {
+	if r == nil {
+		return nil
+	}
+	if len(r.Titles) > 0 {
+		return r.Titles
+	}
+	return r.Results
+}
+
+type TranslationData struct {
+	Title    string `json:"title,omitempty"` // movie
+	Name     string `json:"name,omitempty"`  // tv
+	Overview string `json:"overview"`
+	Tagline  string `json:"tagline,omitempty"`
+	Homepage string `json:"homepage,omitempty"`
+}
+
+func (d TranslationData) DisplayTitle() string {
+	if d.Title != "" {
+		return d.Title
+	}
+	return d.Name
+}
+
+type Translation struct {
+	Country     string          `json:"iso_3166_1"`
+	Language    string          `json:"iso_639_1"`
+	Name        string          `json:"name"`
+	EnglishName string          `json:"english_name"`
+	Data        TranslationData `json:"data"`
+}
+
+type TranslationsResponse struct {
+	Translations []Translation `json:"translations"`
+}
+
+func (r *TranslationsResponse) List() []Translation {
+	if r == nil {
+		return nil
+	}
+	return r.Translations
+}
+
+type SearchKeywordsResponse struct {
+	Page         int       `json:"page"`
+	Results      []Keyword `json:"results"`
+	TotalPages   int       `json:"total_pages"`
+	TotalResults int       `json:"total_results"`
+}
+
+// Collections, companies and networks
+
+type CollectionResult struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Overview string `json:"overview"`
+}
+
+type SearchCollectionsResponse struct {
+	Page         int                `json:"page"`
+	Results      []CollectionResult `json:"results"`
+	TotalPages   int                `json:"total_pages"`
+	TotalResults int                `json:"total_results"`
+}
+
+type CollectionDetails struct {
+	ID       int           `json:"id"`
+	Name     string        `json:"name"`
+	Overview string        `json:"overview"`
+	Parts    []MovieResult `json:"parts"`
+}
+
+type CompanyResult struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	OriginCountry string `json:"origin_country"`
+}
+
+type SearchCompaniesResponse struct {
+	Page         int             `json:"page"`
+	Results      []CompanyResult `json:"results"`
+	TotalPages   int             `json:"total_pages"`
+	TotalResults int             `json:"total_results"`
+}
+
+type CompanyDetails struct {
+	ID            int            `json:"id"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	Headquarters  string         `json:"headquarters"`
+	Homepage      string         `json:"homepage"`
+	OriginCountry string         `json:"origin_country"`
+	ParentCompany *CompanyResult `json:"parent_company"`
+}
+
+type NetworkDetails struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	Headquarters  string `json:"headquarters"`
+	Homepage      string `json:"homepage"`
+	OriginCountry string `json:"origin_country"`
+}
+
+// External IDs
+
+// ExternalIDs holds the IDs other services use for a movie, show, episode or
+// person. Not every field applies to every type.
+type ExternalIDs struct {
+	ID          int    `json:"id"`
+	IMDbID      string `json:"imdb_id,omitempty"`
+	TVDBID      int    `json:"tvdb_id,omitempty"`
+	WikidataID  string `json:"wikidata_id,omitempty"`
+	FacebookID  string `json:"facebook_id,omitempty"`
+	InstagramID string `json:"instagram_id,omitempty"`
+	TwitterID   string `json:"twitter_id,omitempty"`
+	TikTokID    string `json:"tiktok_id,omitempty"`
+	YouTubeID   string `json:"youtube_id,omitempty"`
+	FreebaseMID string `json:"freebase_mid,omitempty"`
+	TVRageID    int    `json:"tvrage_id,omitempty"`
+}
+
+// Find by external ID
+
+type FindResponse struct {
+	MovieResults     []MovieResult  `json:"movie_results"`
+	TVResults        []TVResult     `json:"tv_results"`
+	PersonResults    []PersonResult `json:"person_results"`
+	TVEpisodeResults []TVEpisode    `json:"tv_episode_results"`
+	TVSeasonResults  []TVSeason     `json:"tv_season_results"`
+}
+
+// Watch providers
+
+type WatchProvider struct {
+	ProviderID      int    `json:"provider_id"`
+	ProviderName    string `json:"provider_name"`
+	LogoPath        string `json:"logo_path,omitempty"`
+	DisplayPriority int    `json:"display_priority"`
+}
+
+// WatchOptions lists the providers for one region, by offer type.
+type WatchOptions struct {
+	Link     string          `json:"link"`
+	Flatrate []WatchProvider `json:"flatrate,omitempty"`
+	Free     []WatchProvider `json:"free,omitempty"`
+	Ads      []WatchProvider `json:"ads,omitempty"`
+	Rent     []WatchProvider `json:"rent,omitempty"`
+	Buy      []WatchProvider `json:"buy,omitempty"`
+}
+
+// Streaming returns providers that include the title without a purchase:
+// subscription, free and ad-supported.
+func (w WatchOptions) Streaming() []WatchProvider {
+	var all []WatchProvider
+	all = append(all, w.Flatrate...)
+	all = append(all, w.Free...)
+	all = append(all, w.Ads...)
+	return all
+}
+
+type WatchProvidersResponse struct {
+	ID      int                     `json:"id"`
+	Results map[string]WatchOptions `json:"results"`
+}
+
+type ProviderListResponse struct {
+	Results []WatchProvider `json:"results"`
+}
+
+type WatchRegion struct {
+	ISO31661    string `json:"iso_3166_1"`
+	EnglishName string `json:"english_name"`
+	NativeName  string `json:"native_name"`
+}
+
+type WatchRegionsResponse struct {
+	Results []WatchRegion `json:"results"`
+}
+
+// Release dates and certifications
+
+type ReleaseDate struct {
+	Certification string   `json:"certification"`
+	Descriptors   []string `json:"descriptors,omitempty"`
+	Language      string   `json:"iso_639_1"`
+	Note          string   `json:"note"`
+	ReleaseDate   string   `json:"release_date"`
+	Type          int      `json:"type"`
+}
+
+type CountryReleaseDates struct {
+	ISO31661     string        `json:"iso_3166_1"`
+	ReleaseDates []ReleaseDate `json:"release_dates"`
+}
+
+type ReleaseDatesResponse struct {
+	Results []CountryReleaseDates `json:"results"`
+}
+
+type ContentRating struct {
+	ISO31661    string   `json:"iso_3166_1"`
+	Rating      string   `json:"rating"`
+	Descriptors []string `json:"descriptors,omitempty"`
+}
+
+type ContentRatingsResponse struct {
+	Results []ContentRating `json:"results"`
+}
+
+type Certification struct {
+	Certification string `json:"certification"`
+	Meaning       string `json:"meaning"`
+	Order         int    `json:"order"`
+}
+
+type CertificationsResponse struct {
+	Certifications map[string][]Certification `json:"certifications"`
+}
+
+// Videos
+
+type Video struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Key         string `json:"key"`
+	Site        string `json:"site"`
+	Type        string `json:"type"` // Trailer, Teaser, Featurette, Clip, ...
+	Size        int    `json:"size"`
+	Official    bool   `json:"official"`
+	Language    string `json:"iso_639_1"`
+	Country     string `json:"iso_3166_1"`
+	PublishedAt string `json:"published_at"`
+}
+
+type VideosResponse struct {
+	ID      int     `json:"id"`
+	Results []Video `json:"results"`
+}
+
+// Images
+
+type ImageConfig struct {
+	SecureBaseURL string   `json:"secure_base_url"`
+	BackdropSizes []string `json:"backdrop_sizes"`
+	LogoSizes     []string `json:"logo_sizes"`
+	PosterSizes   []string `json:"poster_sizes"`
+	ProfileSizes  []string `json:"profile_sizes"`
+	StillSizes    []string `json:"still_sizes"`
+}
+
+type ConfigurationResponse struct {
+	Images     ImageConfig `json:"images"`
+	ChangeKeys []string    `json:"change_keys"`
+}
+
+type Image struct {
+	FilePath    string  `json:"file_path"`
+	Width       int     `json:"width"`
+	Height      int     `json:"height"`
+	AspectRatio float64 `json:"aspect_ratio"`
+	Language    string  `json:"iso_639_1"`
+	VoteAverage float64 `json:"vote_average"`
+	VoteCount   int     `json:"vote_count"`
+}
+
+type ImagesResponse struct {
+	ID        int     `json:"id"`
+	Backdrops []Image `json:"backdrops"`
+	Logos     []Image `json:"logos"`
+	Posters   []Image `json:"posters"`
+	Profiles  []Image `json:"profiles"`
+	Stills    []Image `json:"stills"`
+}
+
+// Lists
+
+// ListItem is a movie or TV show on a list. Comment is only sent when
+// adding or updating items.
+type ListItem struct {
+	MediaType string `json:"media_type"`
+	MediaID   int    `json:"media_id"`
+	Comment   string `json:"comment,omitempty"`
+}
+
+type ListItemResult struct {
+	MediaType string `json:"media_type"`
+	MediaID   int    `json:"media_id"`
+	Success   bool   `json:"success"`
+}
+
+type ListItemsResponse struct {
+	Results []ListItemResult `json:"results"`
+}
+
+type ListSummary struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Public        int    `json:"public"` // 1 when public
+	NumberOfItems int    `json:"number_of_items"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+type ListsResponse struct {
+	Page         int           `json:"page"`
+	Results      []ListSummary `json:"results"`
+	TotalPages   int           `json:"total_pages"`
+	TotalResults int           `json:"total_results"`
+}
+
+type ListDetails struct {
+	ID           int               `json:"id"`
+	Name         string            `json:"name"`
+	Description  string            `json:"description"`
+	Public       bool              `json:"public"`
+	SortBy       string            `json:"sort_by"`
+	Comments     map[string]string `json:"comments"` // keyed by "movie:603"
+	Page         int               `json:"page"`
+	Results      []MultiResult     `json:"results"`
+	TotalPages   int               `json:"total_pages"`
+	TotalResults int               `json:"total_results"`
+}
+
+// Reference data
+
+type Country struct {
+	Code        string `json:"iso_3166_1"`
+	EnglishName string `json:"english_name"`
+	NativeName  string `json:"native_name"`
+}
+
+type Language struct {
+	Code        string `json:"iso_639_1"`
+	EnglishName string `json:"english_name"`
+	Name        string `json:"name"` // native name, may be empty
+}
+
+type Timezones struct {
+	Country string   `json:"iso_3166_1"`
+	Zones   []string `json:"zones"`
+}
+
+type DepartmentJobs struct {
+	Department string   `json:"department"`
+	Jobs       []string `json:"jobs"`
+}
+
+// Changes
+
+type ChangedID struct {
+	ID    int  `json:"id"`
+	Adult bool `json:"adult"`
+}
+
+type ChangedIDsResponse struct {
+	Page         int         `json:"page"`
+	Results      []ChangedID `json:"results"`
+	TotalPages   int         `json:"total_pages"`
+	TotalResults int         `json:"total_results"`
+}
+
+type ChangeItem struct {
+	ID            string          `json:"id"`
+	Action        string          `json:"action"` // added, updated, deleted
+	Time          string          `json:"time"`
+	Language      string          `json:"iso_639_1,omitempty"`
+	Country       string          `json:"iso_3166_1,omitempty"`
+	Value         json.RawMessage `json:"value,omitempty"`
+	OriginalValue json.RawMessage `json:"original_value,omitempty"`
+}
+
+// Change groups the changes to one field, such as release_dates or season.
+type Change struct {
+	Key   string       `json:"key"`
+	Items []ChangeItem `json:"items"`
+}
+
+type ChangesResponse struct {
+	Changes []Change `json:"changes"`
+}
+
+// Reviews
+
+type AuthorDetails struct {
+	Name     string   `json:"name"`
+	Username string   `json:"username"`
+	Rating   *float64 `json:"rating"` // nil when the author gave no rating
+}
+
+type Review struct {
+	ID            string        `json:"id"`
+	Author        string        `json:"author"`
+	AuthorDetails AuthorDetails `json:"author_details"`
+	Content       string        `json:"content"`
+	CreatedAt     string        `json:"created_at"`
+	UpdatedAt     string        `json:"updated_at"`
+	URL           string        `json:"url"`
+	MediaType     string        `json:"media_type,omitempty"`  // single review only
+	MediaID       int           `json:"media_id,omitempty"`    // single review only
+	MediaTitle    string        `json:"media_title,omitempty"` // single review only
+}
+
+type ReviewsResponse struct {
+	ID           int      `json:"id"`
+	Page         int      `json:"page"`
+	Results      []Review `json:"results"`
+	TotalPages   int      `json:"total_pages"`
+	TotalResults int      `json:"total_results"`
+}
+
+// People
+
+type PersonDetails struct {
+	ID                 int          `json:"id"`
+	Name               string       `json:"name"`
+	KnownForDepartment string       `json:"known_for_department"`
+	Birthday           string       `json:"birthday"`
+	Deathday           string       `json:"deathday"`
+	PlaceOfBirth       string       `json:"place_of_birth"`
+	Biography          string       `json:"biography"`
+	IMDbID             string       `json:"imdb_id"`
+	ExternalIDs        *ExternalIDs `json:"external_ids,omitempty"`
+}
+
 // Credits / Filmography
 
 type CastCredit struct {
@@ -67,6 +620,7 @@ type CombinedCreditsResponse struct {
 
 type TVSeason struct {
 	ID           int    `json:"id"`
+	ShowID       int    `json:"show_id,omitempty"`
 	SeasonNumber int    `json:"season_number"`
 	Name         string `json:"name"`
 	EpisodeCount int    `json:"episode_count"`
@@ -74,21 +628,64 @@ type TVSeason struct {
 }
 
 type TVDetails struct {
-	ID           int        `json:"id"`
-	Name         string     `json:"name"`
-	FirstAirDate string     `json:"first_air_date"`
-	Seasons      []TVSeason `json:"seasons"`
-	Overview     string     `json:"overview"`
+	ID                int                        `json:"id"`
+	Name              string                     `json:"name"`
+	FirstAirDate      string                     `json:"first_air_date"`
+	Seasons           []TVSeason                 `json:"seasons"`
+	Overview          string                     `json:"overview"`
+	Genres            []Genre                    `json:"genres,omitempty"`
+	ExternalIDs       *ExternalIDs               `json:"external_ids,omitempty"`
+	ContentRatings    *ContentRatingsResponse    `json:"content_ratings,omitempty"`
+	Keywords          *KeywordsResponse          `json:"keywords,omitempty"`
+	AlternativeTitles *AlternativeTitlesResponse `json:"alternative_titles,omitempty"`
+	Translations      *TranslationsResponse      `json:"translations,omitempty"`
+	PosterPath        string                     `json:"poster_path,omitempty"`
+	PosterURL         string                     `json:"poster_url,omitempty"`
 }
 
 type TVEpisode struct {
-	ID            int     `json:"id"`
-	EpisodeNumber int     `json:"episode_number"`
-	SeasonNumber  int     `json:"season_number"`
-	Name          string  `json:"name"`
-	AirDate       string  `json:"air_date"`
-	Overview      string  `json:"overview"`
-	VoteAverage   float64 `json:"vote_average"`
+	ID            int          `json:"id"`
+	ShowID        int          `json:"show_id,omitempty"`
+	EpisodeNumber int          `json:"episode_number"`
+	SeasonNumber  int          `json:"season_number"`
+	Name          string       `json:"name"`
+	AirDate       string       `json:"air_date"`
+	Overview      string       `json:"overview"`
+	VoteAverage   float64      `json:"vote_average"`
+	ExternalIDs   *ExternalIDs `json:"external_ids,omitempty"`
+}
+
+// Episode groups
+
+type EpisodeGroup struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Type         int    `json:"type"`
+	EpisodeCount int    `json:"episode_count"`
+	GroupCount   int    `json:"group_count"`
+}
+
+type EpisodeGroupsResponse struct {
+	Results []EpisodeGroup `json:"results"`
+}
+
+// GroupEpisode is a canonical episode with its position in an episode group.
+type GroupEpisode struct {
+	TVEpisode
+	Order int `json:"order"`
+}
+
+type EpisodeGroupSeason struct {
+	ID       string         `json:"id"`
+	Name     string         `json:"name"`
+	Order    int            `json:"order"`
+	Episodes []GroupEpisode `json:"episodes"`
+}
+
+type EpisodeGroupDetails struct {
+	EpisodeGroup
+	Groups []EpisodeGroupSeason `json:"groups"`
 }
 
 type SeasonDetails struct {
@@ -100,22 +697,75 @@ type SeasonDetails struct {
 
 // Movie full details (with credits)
 
+type CastMember struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Character string `json:"character"`
+	Order     int    `json:"order"`
+}
+
 type CrewMember struct {
-	Name string `json:"name"`
-	Job  string `json:"job"`
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Department string `json:"department"`
+	Job        string `json:"job"`
 }
 
 type MovieCredits struct {
+	ID   int          `json:"id,omitempty"`
+	Cast []CastMember `json:"cast,omitempty"`
 	Crew []CrewMember `json:"crew"`
 }
 
+// Aggregate TV credits
+
+type AggregateRole struct {
+	Character    string `json:"character"`
+	EpisodeCount int    `json:"episode_count"`
+}
+
+type AggregateJob struct {
+	Job          string `json:"job"`
+	EpisodeCount int    `json:"episode_count"`
+}
+
+type AggregateCastMember struct {
+	ID                int             `json:"id"`
+	Name              string          `json:"name"`
+	Roles             []AggregateRole `json:"roles"`
+	TotalEpisodeCount int             `json:"total_episode_count"`
+	Order             int             `json:"order"`
+}
+
+type AggregateCrewMember struct {
+	ID                int            `json:"id"`
+	Name              string         `json:"name"`
+	Department        string         `json:"department"`
+	Jobs              []AggregateJob `json:"jobs"`
+	TotalEpisodeCount int            `json:"total_episode_count"`
+}
+
+type AggregateCredits struct {
+	ID   int                   `json:"id"`
+	Cast []AggregateCastMember `json:"cast,omitempty"`
+	Crew []AggregateCrewMember `json:"crew,omitempty"`
+}
+
 type MovieFullDetails struct {
-	ID            int          `json:"id"`
-	Title         string       `json:"title"`
-	OriginalTitle string       `json:"original_title"`
-	ReleaseDate   string       `json:"release_date"`
-	Overview      string       `json:"overview"`
-	Credits       MovieCredits `json:"credits"`
+	ID                int                        `json:"id"`
+	Title             string                     `json:"title"`
+	OriginalTitle     string                     `json:"original_title"`
+	ReleaseDate       string                     `json:"release_date"`
+	Overview          string                     `json:"overview"`
+	Genres            []Genre                    `json:"genres,omitempty"`
+	Credits           MovieCredits               `json:"credits"`
+	ExternalIDs       *ExternalIDs               `json:"external_ids,omitempty"`
+	ReleaseDates      *ReleaseDatesResponse      `json:"release_dates,omitempty"`
+	Keywords          *KeywordsResponse          `json:"keywords,omitempty"`
+	AlternativeTitles *AlternativeTitlesResponse `json:"alternative_titles,omitempty"`
+	Translations      *TranslationsResponse      `json:"translations,omitempty"`
+	PosterPath        string                     `json:"poster_path,omitempty"`
+	PosterURL         string                     `json:"poster_url,omitempty"`
 }
 
 func (m *MovieFullDetails) Director() string {
@@ -139,6 +789,12 @@ type SessionResponse struct {
 	SessionID string `json:"session_id"`
 }
 
+type AccessTokenResponse struct {
+	Success     bool   `json:"success"`
+	AccessToken string `json:"access_token"`
+	AccountID   string `json:"account_id"`
+}
+
 type AccountResponse struct {
 	ID       int    `json:"id"`
 	Username string `json:"username"`
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// URL returns a playable link for YouTube and Vimeo videos, or "".
+func (v Video) URL() string {
+	switch v.Site {
+	case "YouTube":
+		return "https://www.youtube.com/watch?v=" + url.QueryEscape(v.Key)
+	case "Vimeo":
+		return "https://vimeo.com/" + url.PathEscape(v.Key)
+	}
+	return ""
+}
+
+// BestTrailer picks the most representative trailer: trailers over teasers,
+// official over fan uploads, playable over unplayable, then highest
+// resolution and earliest publication. It returns nil if there is none.
+func BestTrailer(videos []Video) *Video {
+	var candidates []Video
+	for _, v := range videos {
+		if (v.Type == "Trailer" || v.Type == "Teaser") && v.URL() != "" {
+			candidates = append(candidates, v)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Type != b.Type {
+			return a.Type == "Trailer"
+		}
+		if a.Official != b.Official {
+			return a.Official
+		}
+		if a.Size != b.Size {
+			return a.Size > b.Size
+		}
+		return a.PublishedAt < b.PublishedAt
+	})
+	return &candidates[0]
+}
+
+func (c *Client) videos(path, language, what string) ([]Video, error) {
+	var params url.Values
+	if language != "" {
+		params = url.Values{
+			"language":               {language},
+			"include_video_language": {language + ",en,null"},
+		}
+	}
+	data, err := c.get(path, params)
+	if err != nil {
+		return nil, fmt.Errorf("getting %s videos: %w", what, err)
+	}
+	var resp VideosResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+func (c *Client) MovieVideos(movieID int, language string) ([]Video, error) {
+	return c.videos(fmt.Sprintf("/movie/%d/videos", movieID), language, "movie")
+}
+
+func (c *Client) TVVideos(seriesID int, language string) ([]Video, error) {
+	return c.videos(fmt.Sprintf("/tv/%d/videos", seriesID), language, "TV")
+}
+
+func (c *Client) SeasonVideos(seriesID, season int, language string) ([]Video, error) {
+	return c.videos(fmt.Sprintf("/tv/%d/season/%d/videos", seriesID, season), language, "season")
+}
+
+func (c *Client) EpisodeVideos(seriesID, season, episode int, language string) ([]Video, error) {
+	path := fmt.Sprintf("/tv/%d/season/%d/episode/%d/videos", seriesID, season, episode)
+	return c.videos(path, language, "episode")
+}
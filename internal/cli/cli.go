@@ -0,0 +1,363 @@
+// Package cli is a small command framework: a tree of commands, each with
+// its own flags and help text, from which the usage listing is generated.
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Flag is an option written --name, or --name value / --name=value when it
+// takes a value.
+type Flag struct {
+	Name  string // without the leading dashes
+	Value string // placeholder for the value ("FI"); empty for switches
+	Usage string
+}
+
+// Command is a command or subcommand.
+type Command struct {
+	Name    string
+	Args    string // positional arguments, for usage lines
+	Summary string // for the command list; "\n" starts a continuation line
+	Help    string // more detail, shown by --help
+	Flags   []Flag
+
+	// Subcommands are selected by the first argument. A command that has
+	// subcommands and no Args of its own needs one of them.
+	Subcommands []*Command
+
+	// Run executes the command. A subcommand without Run is handled by its
+	// parent's Run, which then gets the subcommand name as its first argument.
+	Run func(*Context)
+}
+
+// App is the root of the command tree.
+type App struct {
+	Name     string
+	Summary  string
+	Flags    []Flag // global flags, accepted by every command
+	Commands []*Command
+	Footer   string // notes and examples printed after the generated lists
+
+	// Before, if set, runs with the parsed context just before the command.
+	Before func(*Context)
+}
+
+// Context is what a command runs with: the top-level command name as typed,
+// the positional arguments and the flags that were set.
+type Context struct {
+	Name  string
+	Args  []string
+	flags map[string]string
+	app   *App
+	path  []*Command
+}
+
+// Bool reports whether a flag was given.
+func (c *Context) Bool(name string) bool {
+	_, ok := c.flags[name]
+	return ok
+}
+
+// String returns a flag's value, or "" when it wasn't given.
+func (c *Context) String(name string) string {
+	return c.flags[name]
+}
+
+// Help writes the generated help for the command being run, as --help
+// would, so handlers can show it when their arguments are wrong.
+func (c *Context) Help(w io.Writer) {
+	c.app.CommandHelp(w, c.path)
+}
+
+var helpFlag = Flag{Name: "help", Usage: "Show help for the command"}
+
+// Run parses args (without the program name) and runs the command they
+// name. "help [command]", --help and -h print help instead.
+func (a *App) Run(args []string) error {
+	// Global flags may come before the command.
+	global := map[string]string{}
+	for len(args) > 0 && strings.HasPrefix(args[0], "-") && args[0] != "-" {
+		if args[0] == "-h" || args[0] == "--help" {
+			a.Usage(os.Stdout)
+			return nil
+		}
+		var err error
+		if args, err = a.takeFlag(args, a.Flags, global, ""); err != nil {
+			return err
+		}
+	}
+	if len(args) == 0 {
+		a.Usage(os.Stderr)
+		return fmt.Errorf("no command given")
+	}
+	if args[0] == "help" {
+		return a.help(args[1:])
+	}
+
+	cmd := a.find(args[0])
+	if cmd == nil {
+		return fmt.Errorf("unknown command %q%s; run \"%s help\" for the list", args[0], suggest(args[0], a.names()), a.Name)
+	}
+	args = args[1:]
+	path := []*Command{cmd}
+	target := cmd
+	if len(cmd.Subcommands) > 0 && len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		if sub := findIn(cmd.Subcommands, args[0]); sub != nil {
+			target = sub
+			path = append(path, sub)
+			args = args[1:]
+		} else if cmd.Args == "" {
+			return fmt.Errorf("unknown %s command %q%s", cmd.Name, args[0], suggest(args[0], commandNames(cmd.Subcommands)))
+		}
+	}
+
+	ctx := &Context{Name: cmd.Name, flags: global, app: a, path: path}
+	allowed := append(append([]Flag{}, target.Flags...), a.Flags...)
+	for len(args) > 0 {
+		arg := args[0]
+		switch {
+		case arg == "--":
+			ctx.Args = append(ctx.Args, args[1:]...)
+			args = nil
+		case arg == "-h" || arg == "--help":
+			a.CommandHelp(os.Stdout, path)
+			return nil
+		case strings.HasPrefix(arg, "--"):
+			var err error
+			if args, err = a.takeFlag(args, allowed, ctx.flags, commandPath(path)); err != nil {
+				return err
+			}
+		default:
+			ctx.Args = append(ctx.Args, arg)
+			args = args[1:]
+		}
+	}
+
+	if a.Before != nil && (target.Run != nil || cmd.Run != nil) {
+		a.Before(ctx)
+	}
+	switch {
+	case target.Run != nil:
+		target.Run(ctx)
+	case cmd.Run != nil:
+		ctx.Args = append([]string{target.Name}, ctx.Args...)
+		cmd.Run(ctx)
+	default:
+		a.CommandHelp(os.Stderr, path)
+		return fmt.Errorf("missing %s command", cmd.Name)
+	}
+	return nil
+}
+
+// takeFlag reads the flag at the start of args into values and returns the
+// remaining arguments. where names the command for error messages.
+func (a *App) takeFlag(args []string, allowed []Flag, values map[string]string, where string) ([]string, error) {
+	name, value, hasValue := strings.Cut(strings.TrimPrefix(args[0], "--"), "=")
+	var flag *Flag
+	for i := range allowed {
+		if allowed[i].Name == name {
+			flag = &allowed[i]
+		}
+	}
+	if flag == nil {
+		var names []string
+		for _, f := range allowed {
+			names = append(names, "--"+f.Name)
+		}
+		if where == "" {
+			return nil, fmt.Errorf("unknown option --%s%s", name, suggest("--"+name, names))
+		}
+		return nil, fmt.Errorf("unknown option --%s for %s%s; run \"%s %s --help\"", name, where, suggest("--"+name, names), a.Name, where)
+	}
+	switch {
+	case flag.Value == "" && hasValue:
+		return nil, fmt.Errorf("--%s takes no value", name)
+	case flag.Value == "":
+		value = "true"
+	case !hasValue:
+		if len(args) < 2 {
+			return nil, fmt.Errorf("--%s needs a value (%s)", name, flag.Value)
+		}
+		value = args[1]
+		args = args[1:]
+	}
+	values[name] = value
+	return args[1:], nil
+}
+
+func (a *App) help(args []string) error {
+	if len(args) == 0 {
+		a.Usage(os.Stdout)
+		return nil
+	}
+	cmd := a.find(args[0])
+	if cmd == nil {
+		return fmt.Errorf("unknown command %q%s", args[0], suggest(args[0], a.names()))
+	}
+	path := []*Command{cmd}
+	if len(args) > 1 {
+		if sub := findIn(cmd.Subcommands, args[1]); sub != nil {
+			path = append(path, sub)
+		}
+	}
+	a.CommandHelp(os.Stdout, path)
+	return nil
+}
+
+func (a *App) find(name string) *Command {
+	return findIn(a.Commands, name)
+}
+
+func (a *App) names() []string {
+	return commandNames(a.Commands)
+}
+
+func findIn(cmds []*Command, name string) *Command {
+	for _, c := range cmds {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func commandNames(cmds []*Command) []string {
+	names := make([]string, len(cmds))
+	for i, c := range cmds {
+		names[i] = c.Name
+	}
+	return names
+}
+
+func commandPath(path []*Command) string {
+	return strings.Join(commandNames(path), " ")
+}
+
+// suggest returns ` (did you mean "x"?)` for the closest name within a few
+// edits of input, or "".
+func suggest(input string, names []string) string {
+	best, bestDist := "", len(input)/3+2
+	for _, n := range names {
+		if d := Levenshtein(input, n); d < bestDist {
+			best, bestDist = n, d
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (did you mean %q?)", best)
+}
+
+// Levenshtein returns the edit distance between a and b.
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(rb)]
+}
+
+// summaryColumn is where summaries start in command lists.
+const summaryColumn = 33
+
+// Usage writes the full command listing, global flags and footer.
+func (a *App) Usage(w io.Writer) {
+	fmt.Fprintf(w, "%s\n\nUsage: %s <command> [options]\n\nCommands:\n", a.Summary, a.Name)
+	for _, c := range a.Commands {
+		if c.Args != "" || len(c.Subcommands) == 0 {
+			writeCommandLine(w, c.Name, c)
+		}
+		for _, sub := range c.Subcommands {
+			writeCommandLine(w, c.Name+" "+sub.Name, sub)
+		}
+	}
+	fmt.Fprintf(w, "\nGlobal options:\n")
+	writeFlags(w, append(append([]Flag{}, a.Flags...), helpFlag))
+	fmt.Fprintf(w, "\nRun \"%s help <command>\" for a command's own options.\n", a.Name)
+	if a.Footer != "" {
+		fmt.Fprintf(w, "\n%s", a.Footer)
+	}
+}
+
+// CommandHelp writes usage, description and flags for a command; path is
+// the command followed by the chosen subcommand, if any.
+func (a *App) CommandHelp(w io.Writer, path []*Command) {
+	c := path[len(path)-1]
+	usage := strings.TrimSpace(a.Name + " " + commandPath(path) + " " + c.Args)
+	fmt.Fprintf(w, "Usage: %s [options]\n", usage)
+	if c.Summary != "" {
+		fmt.Fprintf(w, "\n%s\n", joinSummary(c.Summary))
+	}
+	if c.Help != "" {
+		fmt.Fprintf(w, "\n%s\n", strings.TrimRight(c.Help, "\n"))
+	}
+	if len(c.Subcommands) > 0 {
+		fmt.Fprintf(w, "\nCommands:\n")
+		for _, sub := range c.Subcommands {
+			writeCommandLine(w, c.Name+" "+sub.Name, sub)
+		}
+	}
+	if len(c.Flags) > 0 {
+		fmt.Fprintf(w, "\nOptions:\n")
+		writeFlags(w, c.Flags)
+	}
+	fmt.Fprintf(w, "\nGlobal options:\n")
+	writeFlags(w, append(append([]Flag{}, a.Flags...), helpFlag))
+}
+
+func writeCommandLine(w io.Writer, name string, c *Command) {
+	head := "  " + strings.TrimSpace(name+" "+c.Args)
+	lines := strings.Split(c.Summary, "\n")
+	var line string
+	if len(head) < summaryColumn {
+		line = fmt.Sprintf("%-*s%s", summaryColumn, head, lines[0])
+	} else {
+		line = head + "  " + lines[0]
+	}
+	fmt.Fprintln(w, strings.TrimRight(line, " "))
+	for _, l := range lines[1:] {
+		fmt.Fprintf(w, "%*s%s\n", summaryColumn, "", l)
+	}
+}
+
+func writeFlags(w io.Writer, flags []Flag) {
+	width := 0
+	for _, f := range flags {
+		width = max(width, len(flagHead(f)))
+	}
+	for _, f := range flags {
+		lines := strings.Split(f.Usage, "\n")
+		fmt.Fprintf(w, "  %-*s  %s\n", width, flagHead(f), lines[0])
+		for _, l := range lines[1:] {
+			fmt.Fprintf(w, "  %-*s  %s\n", width, "", l)
+		}
+	}
+}
+
+func flagHead(f Flag) string {
+	if f.Value == "" {
+		return "--" + f.Name
+	}
+	return "--" + f.Name + " " + f.Value
+}
+
+func joinSummary(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
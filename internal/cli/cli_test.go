@@ -0,0 +1,173 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"fi", "fr", 1},
+	}
+	for _, tt := range tests {
+		if got := Levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func testApp(got **Context) *App {
+	run := func(c *Context) { *got = c }
+	return &App{
+		Name:  "tool",
+		Flags: []Flag{{Name: "json", Usage: "JSON output"}},
+		Commands: []*Command{
+			{Name: "rate", Args: "<ref> <rating>", Flags: []Flag{{Name: "group", Value: "<id>"}, {Name: "resolve"}}, Run: run},
+			{Name: "images", Args: "<ref>", Flags: []Flag{{Name: "size", Value: "w500"}}, Run: run, Subcommands: []*Command{
+				{Name: "download", Args: "<ref>", Flags: []Flag{{Name: "dir", Value: "DIR"}}},
+			}},
+			{Name: "watchlist", Run: run, Subcommands: []*Command{
+				{Name: "add", Args: "<ref>"},
+				{Name: "list", Args: "[movie|tv]"},
+			}},
+		},
+	}
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		args  []string
+		name  string
+		pos   []string
+		flags map[string]string
+	}{
+		{[]string{"rate", "tv:1396/S01E05", "8", "--group", "abc", "--json"}, "rate",
+			[]string{"tv:1396/S01E05", "8"}, map[string]string{"group": "abc", "json": "true"}},
+		{[]string{"--json", "rate", "--group=abc", "movie:603", "9"}, "rate",
+			[]string{"movie:603", "9"}, map[string]string{"group": "abc", "json": "true"}},
+		{[]string{"rate", "movie:603", "--", "--5"}, "rate",
+			[]string{"movie:603", "--5"}, map[string]string{}},
+		{[]string{"images", "download", "movie:603", "--dir", "out"}, "images",
+			[]string{"download", "movie:603"}, map[string]string{"dir": "out"}},
+		{[]string{"images", "movie:603", "--size", "w500"}, "images",
+			[]string{"movie:603"}, map[string]string{"size": "w500"}},
+		{[]string{"rate", "--json", "movie:603", "--json", "9"}, "rate",
+			[]string{"movie:603", "9"}, map[string]string{"json": "true"}},
+		{[]string{"watchlist", "add", "movie:603"}, "watchlist",
+			[]string{"add", "movie:603"}, map[string]string{}},
+	}
+	for _, tt := range tests {
+		var got *Context
+		if err := testApp(&got).Run(tt.args); err != nil {
+			t.Errorf("Run(%q) error: %v", tt.args, err)
+			continue
+		}
+		if got == nil {
+			t.Errorf("Run(%q) did not run a command", tt.args)
+			continue
+		}
+		if got.Name != tt.name || strings.Join(got.Args, " ") != strings.Join(tt.pos, " ") {
+			t.Errorf("Run(%q) ran %s %q, want %s %q", tt.args, got.Name, got.Args, tt.name, tt.pos)
+		}
+		for k, v := range tt.flags {
+			if got.String(k) != v {
+				t.Errorf("Run(%q) flag %s = %q, want %q", tt.args, k, got.String(k), v)
+			}
+		}
+		if len(got.flags) != len(tt.flags) {
+			t.Errorf("Run(%q) flags = %v, want %v", tt.args, got.flags, tt.flags)
+		}
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"rat", "movie:603", "9"}, `unknown command "rat" (did you mean "rate"?)`},
+		{[]string{"rate", "movie:603", "9", "--grup", "x"}, `unknown option --grup for rate (did you mean "--group"?)`},
+		{[]string{"images", "movie:603", "--dir", "out"}, `unknown option --dir for images`},
+		{[]string{"watchlist", "ad", "movie:603"}, `unknown watchlist command "ad" (did you mean "add"?)`},
+		{[]string{"rate", "movie:603", "9", "--group"}, `--group needs a value`},
+		{[]string{"rate", "movie:603", "9", "--resolve=yes"}, `--resolve takes no value`},
+		{[]string{"search", "x"}, `unknown command "search"; run`},
+	}
+	for _, tt := range tests {
+		var got *Context
+		err := testApp(&got).Run(tt.args)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Run(%q) error = %v, want %q", tt.args, err, tt.want)
+		}
+		if got != nil {
+			t.Errorf("Run(%q) ran the command despite the error", tt.args)
+		}
+	}
+}
+
+func TestUsage(t *testing.T) {
+	var got *Context
+	app := testApp(&got)
+	app.Commands[0].Summary = "Rate a title\n(1-10)"
+	var b strings.Builder
+	app.Usage(&b)
+	out := b.String()
+	for _, want := range []string{
+		"  rate <ref> <rating>            Rate a title\n                                 (1-10)\n",
+		"  images download <ref>",
+		"  watchlist add <ref>",
+		"  --json  JSON output",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Usage() missing %q in:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "  watchlist  ") {
+		t.Errorf("Usage() lists watchlist without a subcommand:\n%s", out)
+	}
+
+	b.Reset()
+	app.CommandHelp(&b, []*Command{app.Commands[0]})
+	for _, want := range []string{"Usage: tool rate <ref> <rating> [options]", "--group <id>", "--resolve", "--help"} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("CommandHelp() missing %q in:\n%s", want, b.String())
+		}
+	}
+}
+
+func TestBefore(t *testing.T) {
+	var got *Context
+	var before *Context
+	app := testApp(&got)
+	app.Before = func(c *Context) {
+		if got != nil {
+			t.Error("Before ran after the command")
+		}
+		before = c
+	}
+	if err := app.Run([]string{"rate", "movie:603", "9", "--json"}); err != nil {
+		t.Fatal(err)
+	}
+	if before == nil || before != got || !before.Bool("json") {
+		t.Errorf("Before got %+v, command got %+v", before, got)
+	}
+}
+
+func TestContextHelp(t *testing.T) {
+	var got *Context
+	app := testApp(&got)
+	if err := app.Run([]string{"watchlist", "add"}); err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	got.Help(&b)
+	if !strings.HasPrefix(b.String(), "Usage: tool watchlist add <ref> [options]\n") {
+		t.Errorf("Help() =\n%s", b.String())
+	}
+}
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+func cachePath(name string) string {
+	return filepath.Join(Dir(), "cache", name+".json")
+}
+
+// ReadCache decodes a cached value into v. It reports false if the entry is
+// missing, unreadable or older than maxAge.
+func ReadCache(name string, maxAge time.Duration, v any) bool {
+	path := cachePath(name)
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > maxAge {
+		return false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
+
+// WriteCache stores v as a named cache entry.
+func WriteCache(name string, v any) error {
+	path := cachePath(name)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("creating cache dir: %w", err)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
@@ -12,6 +12,7 @@ type Config struct {
 	SessionID      string `json:"session_id,omitempty"`
 	AccountID      int    `json:"account_id,omitempty"`
 	AccountObjectID string `json:"account_object_id,omitempty"`
+	UserAccessToken string `json:"user_access_token,omitempty"` // V4, for editing lists
 }
 
 func Dir() string {
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestSaveAndLoad(t *testing.T) {
@@ -80,3 +81,32 @@ func TestDir(t *testing.T) {
 		t.Fatal("Dir() returned empty string")
 	}
 }
+
+func TestCache(t *testing.T) {
+	tmpDir := t.TempDir()
+	origHome := os.Getenv("HOME")
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", origHome)
+
+	var missing map[string]string
+	if ReadCache("test", time.Hour, &missing) {
+		t.Fatal("expected miss for missing cache entry")
+	}
+
+	if err := WriteCache("test", map[string]string{"key": "value"}); err != nil {
+		t.Fatalf("WriteCache error: %v", err)
+	}
+	var got map[string]string
+	if !ReadCache("test", time.Hour, &got) {
+		t.Fatal("expected cache hit")
+	}
+	if got["key"] != "value" {
+		t.Errorf("cached value = %v", got)
+	}
+
+	old := time.Now().Add(-2 * time.Hour)
+	os.Chtimes(filepath.Join(Dir(), "cache", "test.json"), old, old)
+	if ReadCache("test", time.Hour, &got) {
+		t.Error("expected miss for stale cache entry")
+	}
+}
@@ -3,14 +3,16 @@ package output
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/yareeh/themoviedb-cli/internal/api"
 )
 
 func Movies(movies []api.MovieResult, asJSON bool) {
 	if asJSON {
-		printJSON(movies)
+		printJSON(moviesWithPosters(movies))
 		return
 	}
 	for i, m := range movies {
@@ -25,7 +27,7 @@ func Movies(movies []api.MovieResult, asJSON bool) {
 
 func TVShows(shows []api.TVResult, asJSON bool) {
 	if asJSON {
-		printJSON(shows)
+		printJSON(showsWithPosters(shows))
 		return
 	}
 	for i, s := range shows {
@@ -48,6 +50,643 @@ func People(people []api.PersonResult, asJSON bool) {
 	}
 }
 
+// Multi prints mixed movie, TV and person results with [type:ID] tags.
+func Multi(results []api.MultiResult, asJSON bool) {
+	if asJSON {
+		withPosters := make([]api.MultiResult, len(results))
+		for i, r := range results {
+			if r.Movie != nil {
+				m := *r.Movie
+				m.PosterURL = posterURL(m.PosterPath)
+				r.Movie = &m
+			}
+			if r.TV != nil {
+				s := *r.TV
+				s.PosterURL = posterURL(s.PosterPath)
+				r.TV = &s
+			}
+			withPosters[i] = r
+		}
+		printJSON(withPosters)
+		return
+	}
+	for i, r := range results {
+		printMultiResult(i+1, r)
+	}
+}
+
+func printMultiResult(n int, r api.MultiResult) {
+	switch {
+	case r.Movie != nil:
+		m := r.Movie
+		fmt.Printf("%d. [movie:%d] %s (%s) ★%.1f\n   %s\n", n, m.ID, m.Title, yearFrom(m.ReleaseDate), m.VoteAverage, tmdbURL("movie", m.ID))
+	case r.TV != nil:
+		s := r.TV
+		fmt.Printf("%d. [tv:%d] %s (%s) ★%.1f\n   %s\n", n, s.ID, s.Name, yearFrom(s.FirstAirDate), s.VoteAverage, tmdbURL("tv", s.ID))
+	case r.Person != nil:
+		p := r.Person
+		fmt.Printf("%d. [person:%d] %s (%s)\n   %s\n", n, p.ID, p.Name, p.KnownForDepartment, tmdbURL("person", p.ID))
+	}
+}
+
+// Lists prints list summaries with their item counts.
+func Lists(lists []api.ListSummary, asJSON bool) {
+	if asJSON {
+		printJSON(lists)
+		return
+	}
+	for i, l := range lists {
+		visibility := "private"
+		if l.Public == 1 {
+			visibility = "public"
+		}
+		fmt.Printf("%d. [list:%d] %s (%d items, %s)\n   %s\n", i+1, l.ID, l.Name, l.NumberOfItems, visibility, tmdbURL("list", l.ID))
+	}
+}
+
+// List prints a list's header and one page of its items with comments.
+func List(l *api.ListDetails, asJSON bool) {
+	if asJSON {
+		printJSON(l)
+		return
+	}
+	visibility := "private"
+	if l.Public {
+		visibility = "public"
+	}
+	fmt.Printf("%s [list:%d] (%d items, %s)\n%s\n", l.Name, l.ID, l.TotalResults, visibility, tmdbURL("list", l.ID))
+	if l.Description != "" {
+		fmt.Printf("\n%s\n", Wrap(l.Description, 80))
+	}
+	fmt.Println()
+	for i, r := range l.Results {
+		printMultiResult(i+1, r)
+		if comment := l.Comments[r.Key()]; comment != "" {
+			fmt.Printf("   “%s”\n", comment)
+		}
+	}
+}
+
+// ItemChanges is a title from the user's lists with its recent changes, or
+// the error that kept them from being fetched.
+type ItemChanges struct {
+	MediaType string       `json:"media_type"`
+	ID        int          `json:"id"`
+	Title     string       `json:"title"`
+	Changes   []api.Change `json:"changes"`
+	Error     string       `json:"error,omitempty"`
+}
+
+// Changes prints each title's changes, one line per changed value.
+func Changes(items []ItemChanges, asJSON bool) {
+	if asJSON {
+		printJSON(items)
+		return
+	}
+	for i, item := range items {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("[%s:%d] %s\n", item.MediaType, item.ID, item.Title)
+		if item.Error != "" {
+			fmt.Printf("   error: %s\n", item.Error)
+		}
+		for _, c := range item.Changes {
+			for _, ci := range c.Items {
+				where := ci.Country
+				if where == "" {
+					where = ci.Language
+				}
+				fmt.Printf("   %s  %-16s %-8s %-3s %s\n", dateOnly(ci.Time), c.Key, ci.Action, where, ci.Summary())
+			}
+		}
+	}
+}
+
+// ChangedIDs prints the IDs from a /changes listing.
+func ChangedIDs(mediaType string, ids []api.ChangedID, asJSON bool) {
+	if asJSON {
+		printJSON(ids)
+		return
+	}
+	for _, c := range ids {
+		fmt.Printf("[%s:%d] %s\n", mediaType, c.ID, tmdbURL(mediaType, c.ID))
+	}
+}
+
+func Countries(countries []api.Country, asJSON bool) {
+	if asJSON {
+		printJSON(countries)
+		return
+	}
+	for _, c := range countries {
+		native := ""
+		if c.NativeName != "" && c.NativeName != c.EnglishName {
+			native = fmt.Sprintf(" (%s)", c.NativeName)
+		}
+		fmt.Printf("  %-3s %s%s\n", c.Code, c.EnglishName, native)
+	}
+}
+
+func Languages(languages []api.Language, asJSON bool) {
+	if asJSON {
+		printJSON(languages)
+		return
+	}
+	for _, l := range languages {
+		native := ""
+		if l.Name != "" && l.Name != l.EnglishName {
+			native = fmt.Sprintf(" (%s)", l.Name)
+		}
+		fmt.Printf("  %-3s %s%s\n", l.Code, l.EnglishName, native)
+	}
+}
+
+func Timezones(zones []api.Timezones, asJSON bool) {
+	if asJSON {
+		printJSON(zones)
+		return
+	}
+	for _, z := range zones {
+		fmt.Printf("  %-3s %s\n", z.Country, strings.Join(z.Zones, ", "))
+	}
+}
+
+// Jobs prints crew jobs grouped by department.
+func Jobs(departments []api.DepartmentJobs, asJSON bool) {
+	if asJSON {
+		printJSON(departments)
+		return
+	}
+	for _, d := range departments {
+		fmt.Printf("%s:\n", d.Department)
+		for _, j := range d.Jobs {
+			fmt.Printf("  %s\n", j)
+		}
+	}
+}
+
+// Codes prints one code per line.
+func Codes(codes []string, asJSON bool) {
+	if asJSON {
+		printJSON(codes)
+		return
+	}
+	for _, c := range codes {
+		fmt.Printf("  %s\n", c)
+	}
+}
+
+func Collections(collections []api.CollectionResult, asJSON bool) {
+	if asJSON {
+		printJSON(collections)
+		return
+	}
+	for i, c := range collections {
+		fmt.Printf("%d. [collection:%d] %s\n   %s\n", i+1, c.ID, c.Name, tmdbURL("collection", c.ID))
+	}
+}
+
+func Companies(companies []api.CompanyResult, asJSON bool) {
+	if asJSON {
+		printJSON(companies)
+		return
+	}
+	for i, c := range companies {
+		country := ""
+		if c.OriginCountry != "" {
+			country = fmt.Sprintf(" (%s)", c.OriginCountry)
+		}
+		fmt.Printf("%d. [company:%d] %s%s\n   %s\n", i+1, c.ID, c.Name, country, tmdbURL("company", c.ID))
+	}
+}
+
+func Keywords(keywords []api.Keyword, asJSON bool) {
+	if asJSON {
+		printJSON(keywords)
+		return
+	}
+	for i, k := range keywords {
+		fmt.Printf("%d. [keyword:%d] %s\n   %s\n", i+1, k.ID, k.Name, tmdbURL("keyword", k.ID))
+	}
+}
+
+// Genres prints a genre list with the IDs accepted by discover.
+func Genres(genres []api.Genre, asJSON bool) {
+	if asJSON {
+		printJSON(genres)
+		return
+	}
+	for _, g := range genres {
+		fmt.Printf("  %-6d %s\n", g.ID, g.Name)
+	}
+}
+
+func genreNames(genres []api.Genre) string {
+	names := make([]string, len(genres))
+	for i, g := range genres {
+		names[i] = g.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+func keywordNames(keywords []api.Keyword) string {
+	names := make([]string, len(keywords))
+	for i, k := range keywords {
+		names[i] = k.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// Collection prints a collection with its parts in release order.
+func Collection(c *api.CollectionDetails, asJSON bool) {
+	if asJSON {
+		withPosters := *c
+		withPosters.Parts = moviesWithPosters(c.Parts)
+		printJSON(withPosters)
+		return
+	}
+	fmt.Printf("%s\n%s\n", c.Name, tmdbURL("collection", c.ID))
+	if c.Overview != "" {
+		fmt.Printf("\n%s\n", Wrap(c.Overview, 80))
+	}
+	fmt.Println()
+	Movies(c.Parts, false)
+}
+
+// Company prints company details followed by its movies.
+func Company(c *api.CompanyDetails, movies []api.MovieResult, asJSON bool) {
+	if asJSON {
+		printJSON(struct {
+			*api.CompanyDetails
+			Movies []api.MovieResult `json:"movies"`
+		}{c, moviesWithPosters(movies)})
+		return
+	}
+	fmt.Printf("%s\n%s\n", c.Name, tmdbURL("company", c.ID))
+	printField("Headquarters", c.Headquarters)
+	printField("Country", c.OriginCountry)
+	printField("Homepage", c.Homepage)
+	if c.ParentCompany != nil {
+		printField("Parent", fmt.Sprintf("%s [company:%d]", c.ParentCompany.Name, c.ParentCompany.ID))
+	}
+	if c.Description != "" {
+		fmt.Printf("\n%s\n", Wrap(c.Description, 80))
+	}
+	fmt.Println()
+	Movies(movies, false)
+}
+
+// Network prints network details followed by its shows.
+func Network(n *api.NetworkDetails, shows []api.TVResult, asJSON bool) {
+	if asJSON {
+		printJSON(struct {
+			*api.NetworkDetails
+			Shows []api.TVResult `json:"shows"`
+		}{n, showsWithPosters(shows)})
+		return
+	}
+	fmt.Printf("%s\n%s\n", n.Name, tmdbURL("network", n.ID))
+	printField("Headquarters", n.Headquarters)
+	printField("Country", n.OriginCountry)
+	printField("Homepage", n.Homepage)
+	fmt.Println()
+	TVShows(shows, false)
+}
+
+// Keyword prints a keyword followed by the movies tagged with it.
+func Keyword(k *api.Keyword, movies []api.MovieResult, asJSON bool) {
+	if asJSON {
+		printJSON(struct {
+			*api.Keyword
+			Movies []api.MovieResult `json:"movies"`
+		}{k, moviesWithPosters(movies)})
+		return
+	}
+	fmt.Printf("%s\n%s\n\n", k.Name, tmdbURL("keyword", k.ID))
+	Movies(movies, false)
+}
+
+func printField(label, value string) {
+	if value != "" {
+		fmt.Printf("  %-13s %s\n", label+":", value)
+	}
+}
+
+// Found prints /find results grouped by type.
+func Found(resp *api.FindResponse, asJSON bool) {
+	if asJSON {
+		printJSON(resp)
+		return
+	}
+	n := 0
+	for _, m := range resp.MovieResults {
+		n++
+		fmt.Printf("%d. [movie:%d] %s (%s) ★%.1f\n   %s\n", n, m.ID, m.Title, yearFrom(m.ReleaseDate), m.VoteAverage, tmdbURL("movie", m.ID))
+	}
+	for _, s := range resp.TVResults {
+		n++
+		fmt.Printf("%d. [tv:%d] %s (%s) ★%.1f\n   %s\n", n, s.ID, s.Name, yearFrom(s.FirstAirDate), s.VoteAverage, tmdbURL("tv", s.ID))
+	}
+	for _, s := range resp.TVSeasonResults {
+		n++
+		fmt.Printf("%d. [tv:%d] S%02d: %s (%s)\n", n, s.ShowID, s.SeasonNumber, s.Name, yearFrom(s.AirDate))
+	}
+	for _, e := range resp.TVEpisodeResults {
+		n++
+		fmt.Printf("%d. [tv:%d] S%02dE%02d: %s (%s)\n", n, e.ShowID, e.SeasonNumber, e.EpisodeNumber, e.Name, e.AirDate)
+	}
+	for _, p := range resp.PersonResults {
+		n++
+		fmt.Printf("%d. [person:%d] %s (%s)\n   %s\n", n, p.ID, p.Name, p.KnownForDepartment, tmdbURL("person", p.ID))
+	}
+	if n == 0 {
+		fmt.Println("No results")
+	}
+}
+
+// ExternalIDs prints one line per entry, tagged with refs[i] (movie:603,
+// tv:1396/S05E16): [ref] source:id ...
+func ExternalIDs(refs []string, ids []api.ExternalIDs, asJSON bool) {
+	if asJSON {
+		printJSON(ids)
+		return
+	}
+	for i, e := range ids {
+		fmt.Printf("[%s]%s\n", refs[i], externalIDPairs(e))
+	}
+}
+
+func externalIDPairs(e api.ExternalIDs) string {
+	var b strings.Builder
+	add := func(source, id string) {
+		if id != "" && id != "0" {
+			fmt.Fprintf(&b, " %s:%s", source, id)
+		}
+	}
+	add("imdb", e.IMDbID)
+	add("tvdb", fmt.Sprint(e.TVDBID))
+	add("wikidata", e.WikidataID)
+	add("facebook", e.FacebookID)
+	add("instagram", e.InstagramID)
+	add("twitter", e.TwitterID)
+	add("tiktok", e.TikTokID)
+	add("youtube", e.YouTubeID)
+	add("freebase", e.FreebaseMID)
+	add("tvrage", fmt.Sprint(e.TVRageID))
+	return b.String()
+}
+
+// Providers prints where a title can be watched in one region, grouped by offer type.
+func Providers(options api.WatchOptions, region string, asJSON bool) {
+	if asJSON {
+		printJSON(struct {
+			Region string `json:"region"`
+			api.WatchOptions
+		}{region, options})
+		return
+	}
+	groups := []struct {
+		label     string
+		providers []api.WatchProvider
+	}{
+		{"Stream", options.Flatrate},
+		{"Free", options.Free},
+		{"Ads", options.Ads},
+		{"Rent", options.Rent},
+		{"Buy", options.Buy},
+	}
+	found := false
+	for _, g := range groups {
+		if len(g.providers) == 0 {
+			continue
+		}
+		found = true
+		names := make([]string, len(g.providers))
+		for i, p := range g.providers {
+			names[i] = fmt.Sprintf("%s [%d]", p.ProviderName, p.ProviderID)
+		}
+		fmt.Printf("  %-7s %s\n", g.label+":", strings.Join(names, ", "))
+	}
+	if !found {
+		fmt.Printf("Not available in %s\n", region)
+		return
+	}
+	if options.Link != "" {
+		fmt.Printf("\n%s (data by JustWatch)\n", options.Link)
+	}
+}
+
+func ProviderList(providers []api.WatchProvider, asJSON bool) {
+	if asJSON {
+		printJSON(providers)
+		return
+	}
+	for _, p := range providers {
+		fmt.Printf("[%d] %s\n", p.ProviderID, p.ProviderName)
+	}
+}
+
+func WatchRegions(regions []api.WatchRegion, asJSON bool) {
+	if asJSON {
+		printJSON(regions)
+		return
+	}
+	for _, r := range regions {
+		fmt.Printf("%s  %s\n", r.ISO31661, r.EnglishName)
+	}
+}
+
+// movieDetailsJSON is a movie's full details with the certification and
+// release dates for one region picked out of release_dates.
+type movieDetailsJSON struct {
+	api.MovieFullDetails
+	Region             string            `json:"region"`
+	Certification      string            `json:"certification"`
+	RegionReleaseDates []api.ReleaseDate `json:"region_release_dates"`
+}
+
+// tvDetailsJSON is a show's full details with the content rating for one
+// region.
+type tvDetailsJSON struct {
+	api.TVDetails
+	Region        string `json:"region"`
+	ContentRating string `json:"content_rating"`
+}
+
+// MovieDetails prints a movie's details with the certification and release
+// dates for region.
+func MovieDetails(m *api.MovieFullDetails, region string, asJSON bool) {
+	if asJSON {
+		out := movieDetailsJSON{*m, region, m.Certification(region), m.ReleaseDates.ForRegion(region)}
+		out.PosterURL = posterURL(m.PosterPath)
+		printJSON(out)
+		return
+	}
+	fmt.Printf("%s (%s) [movie:%d]\n%s\n", m.Title, yearFrom(m.ReleaseDate), m.ID, tmdbURL("movie", m.ID))
+	if m.OriginalTitle != "" && m.OriginalTitle != m.Title {
+		printField("Original", m.OriginalTitle)
+	}
+	printField("Director", m.Director())
+	printField("Genres", genreNames(m.Genres))
+	if titles := api.LocalTitles(m.Title, m.AlternativeTitles.List(), m.Translations.List(), region); len(titles) > 0 {
+		printField("Known as", fmt.Sprintf("%s (%s)", strings.Join(titles, ", "), region))
+	}
+	if cert := m.Certification(region); cert != "" {
+		printField("Rated", fmt.Sprintf("%s (%s)", cert, region))
+	}
+	if dates := m.ReleaseDates.ForRegion(region); len(dates) > 0 {
+		fmt.Printf("  Released in %s:\n", region)
+		for _, d := range dates {
+			note := ""
+			if d.Note != "" {
+				note = fmt.Sprintf(" (%s)", d.Note)
+			}
+			fmt.Printf("    %-21s %s%s\n", api.ReleaseTypeName(d.Type), dateOnly(d.ReleaseDate), note)
+		}
+	}
+	printField("Keywords", keywordNames(m.Keywords.List()))
+	if m.Overview != "" {
+		fmt.Printf("\n%s\n", Wrap(m.Overview, 80))
+	}
+}
+
+// TVDetails prints a show's details with its content rating for region.
+func TVDetails(t *api.TVDetails, region string, asJSON bool) {
+	if asJSON {
+		out := tvDetailsJSON{*t, region, t.ContentRating(region)}
+		out.PosterURL = posterURL(t.PosterPath)
+		printJSON(out)
+		return
+	}
+	fmt.Printf("%s (%s) [tv:%d]\n%s\n", t.Name, yearFrom(t.FirstAirDate), t.ID, tmdbURL("tv", t.ID))
+	printField("First aired", t.FirstAirDate)
+	if rating := t.ContentRating(region); rating != "" {
+		printField("Rated", fmt.Sprintf("%s (%s)", rating, region))
+	}
+	printField("Seasons", fmt.Sprint(len(t.Seasons)))
+	printField("Genres", genreNames(t.Genres))
+	if titles := api.LocalTitles(t.Name, t.AlternativeTitles.List(), t.Translations.List(), region); len(titles) > 0 {
+		printField("Known as", fmt.Sprintf("%s (%s)", strings.Join(titles, ", "), region))
+	}
+	printField("Keywords", keywordNames(t.Keywords.List()))
+	if t.Overview != "" {
+		fmt.Printf("\n%s\n", Wrap(t.Overview, 80))
+	}
+}
+
+// Certifications prints the certification system of each country, or only
+// region if set.
+func Certifications(certs map[string][]api.Certification, region string, asJSON bool) {
+	if region != "" {
+		certs = map[string][]api.Certification{region: certs[region]}
+	}
+	if asJSON {
+		printJSON(certs)
+		return
+	}
+	countries := make([]string, 0, len(certs))
+	for c := range certs {
+		countries = append(countries, c)
+	}
+	sort.Strings(countries)
+	for _, c := range countries {
+		list := append([]api.Certification(nil), certs[c]...)
+		sort.SliceStable(list, func(i, j int) bool { return list[i].Order < list[j].Order })
+		fmt.Printf("%s:\n", c)
+		for _, cert := range list {
+			fmt.Printf("  %-6s %s\n", cert.Certification, firstSentence(cert.Meaning))
+		}
+	}
+}
+
+// firstSentence trims long certification meanings to their first sentence.
+func firstSentence(text string) string {
+	if i := strings.Index(text, ". "); i >= 0 {
+		return text[:i+1]
+	}
+	return text
+}
+
+// Videos prints videos with type, language, official flag and a playable URL.
+func Videos(videos []api.Video, asJSON bool) {
+	if asJSON {
+		out := make([]videoJSON, len(videos))
+		for i, v := range videos {
+			out[i] = videoJSON{v, v.URL()}
+		}
+		printJSON(out)
+		return
+	}
+	for i, v := range videos {
+		official := ""
+		if v.Official {
+			official = " official"
+		}
+		link := v.URL()
+		if link == "" {
+			link = fmt.Sprintf("(%s: %s)", v.Site, v.Key)
+		}
+		fmt.Printf("%d. [%s%s, %s] %s (%s)\n   %s\n", i+1, v.Type, official, v.Language, v.Name, dateOnly(v.PublishedAt), link)
+	}
+}
+
+// Trailer prints only the trailer's URL (or the video as JSON).
+func Trailer(v *api.Video, asJSON bool) {
+	if asJSON {
+		printJSON(videoJSON{*v, v.URL()})
+		return
+	}
+	fmt.Println(v.URL())
+}
+
+type videoJSON struct {
+	api.Video
+	URL string `json:"url,omitempty"`
+}
+
+// reviewPreviewLength is how many characters of a review are shown unless full.
+const reviewPreviewLength = 600
+
+// Reviews prints reviews with author, rating and date. Long reviews are
+// shortened unless full is set.
+func Reviews(reviews []api.Review, full, asJSON bool) {
+	if asJSON {
+		printJSON(reviews)
+		return
+	}
+	for i, r := range reviews {
+		if i > 0 {
+			fmt.Println()
+		}
+		rating := ""
+		if r.AuthorDetails.Rating != nil {
+			rating = fmt.Sprintf(" ★%.0f", *r.AuthorDetails.Rating)
+		}
+		fmt.Printf("%d. [review:%s] %s%s (%s)\n", i+1, r.ID, r.Author, rating, dateOnly(r.CreatedAt))
+		content := r.Content
+		if !full {
+			content = truncate(content, reviewPreviewLength)
+		}
+		for _, line := range strings.Split(Wrap(content, 76), "\n") {
+			fmt.Printf("   %s\n", line)
+		}
+	}
+}
+
+// truncate shortens text to at most n characters at a word boundary, adding "…".
+func truncate(text string, n int) string {
+	runes := []rune(text)
+	if len(runes) <= n {
+		return text
+	}
+	cut := string(runes[:n])
+	if i := strings.LastIndexAny(cut, " \n"); i > 0 {
+		cut = cut[:i]
+	}
+	return strings.TrimSpace(cut) + " …"
+}
+
 func Filmography(credits []api.CastCredit, asJSON bool) {
 	if asJSON {
 		printJSON(credits)
@@ -71,6 +710,91 @@ func Filmography(credits []api.CastCredit, asJSON bool) {
 	}
 }
 
+// Credits prints cast in billing order and crew grouped by department.
+func Credits(c *api.MovieCredits, asJSON bool) {
+	if asJSON {
+		printJSON(c)
+		return
+	}
+	if len(c.Cast) > 0 {
+		fmt.Println("Cast:")
+		for i, m := range c.Cast {
+			fmt.Printf("%3d. [person:%d] %s%s\n", i+1, m.ID, m.Name, asCharacter(m.Character))
+		}
+	}
+	for _, dept := range departments(len(c.Crew), func(i int) string { return c.Crew[i].Department }) {
+		fmt.Printf("%s:\n", dept)
+		for _, m := range c.Crew {
+			if m.Department == dept {
+				fmt.Printf("     [person:%d] %s — %s\n", m.ID, m.Name, m.Job)
+			}
+		}
+	}
+}
+
+// AggregateCredits prints series cast and crew with episode counts.
+func AggregateCredits(c *api.AggregateCredits, asJSON bool) {
+	if asJSON {
+		printJSON(c)
+		return
+	}
+	if len(c.Cast) > 0 {
+		fmt.Println("Cast:")
+		for i, m := range c.Cast {
+			var roles []string
+			for _, r := range m.Roles {
+				if r.Character != "" {
+					roles = append(roles, r.Character)
+				}
+			}
+			fmt.Printf("%3d. [person:%d] %s%s (%s)\n", i+1, m.ID, m.Name,
+				asCharacter(strings.Join(roles, " / ")), episodeCount(m.TotalEpisodeCount))
+		}
+	}
+	for _, dept := range departments(len(c.Crew), func(i int) string { return c.Crew[i].Department }) {
+		fmt.Printf("%s:\n", dept)
+		for _, m := range c.Crew {
+			if m.Department != dept {
+				continue
+			}
+			var jobs []string
+			for _, j := range m.Jobs {
+				jobs = append(jobs, fmt.Sprintf("%s (%s)", j.Job, episodeCount(j.EpisodeCount)))
+			}
+			fmt.Printf("     [person:%d] %s — %s\n", m.ID, m.Name, strings.Join(jobs, ", "))
+		}
+	}
+}
+
+// departments returns the distinct departments of n crew entries in order
+// of first appearance.
+func departments(n int, department func(int) string) []string {
+	seen := map[string]bool{}
+	var depts []string
+	for i := 0; i < n; i++ {
+		d := department(i)
+		if !seen[d] {
+			seen[d] = true
+			depts = append(depts, d)
+		}
+	}
+	return depts
+}
+
+func asCharacter(character string) string {
+	if character == "" {
+		return ""
+	}
+	return " as " + character
+}
+
+func episodeCount(n int) string {
+	if n == 1 {
+		return "1 episode"
+	}
+	return fmt.Sprintf("%d episodes", n)
+}
+
 func Seasons(seasons []api.TVSeason, showName string, asJSON bool) {
 	if asJSON {
 		printJSON(seasons)
@@ -93,9 +817,58 @@ func Episodes(episodes []api.TVEpisode, seasonName string, asJSON bool) {
 	}
 }
 
+// Episode prints a single episode's details.
+func Episode(e *api.TVEpisode, asJSON bool) {
+	if asJSON {
+		printJSON(e)
+		return
+	}
+	ref := api.MediaRef{Kind: "tv", ID: e.ShowID, HasSeason: true, Season: e.SeasonNumber, Episode: e.EpisodeNumber}
+	fmt.Printf("S%02dE%02d: %s [%s]\n", e.SeasonNumber, e.EpisodeNumber, e.Name, ref)
+	printField("Aired", e.AirDate)
+	printField("Rating", fmt.Sprintf("★%.1f", e.VoteAverage))
+	if e.Overview != "" {
+		fmt.Printf("\n%s\n", Wrap(e.Overview, 80))
+	}
+}
+
+// EpisodeGroups prints a series' alternate episode orderings.
+func EpisodeGroups(groups []api.EpisodeGroup, asJSON bool) {
+	if asJSON {
+		printJSON(groups)
+		return
+	}
+	for i, g := range groups {
+		fmt.Printf("%d. [group:%s] %s — %s (%d seasons, %d episodes)\n",
+			i+1, g.ID, g.Name, api.EpisodeGroupTypeName(g.Type), g.GroupCount, g.EpisodeCount)
+		if g.Description != "" {
+			fmt.Printf("   %s\n", firstSentence(g.Description))
+		}
+	}
+}
+
+// GroupEpisodes prints season n of an episode group, each episode with its
+// code in the ordering and its canonical TMDB code.
+func GroupEpisodes(s *api.EpisodeGroupSeason, n int, asJSON bool) {
+	if asJSON {
+		printJSON(s.Episodes)
+		return
+	}
+	fmt.Printf("%s:\n", s.Name)
+	for i, e := range s.Episodes {
+		fmt.Printf("  S%02dE%02d (TMDB S%02dE%02d): %s ★%.1f (%s)\n",
+			n, i+1, e.SeasonNumber, e.EpisodeNumber, e.Name, e.VoteAverage, e.AirDate)
+	}
+}
+
 func RatedMovies(movies []api.RatedMovie, asJSON bool) {
 	if asJSON {
-		printJSON(movies)
+		withPosters := make([]api.RatedMovie, len(movies))
+		for i, m := range movies {
+			m.PosterURL = posterURL(m.PosterPath)
+			withPosters[i] = m
+		}
+		printJSON(withPosters)
 		return
 	}
 	for i, m := range movies {
@@ -111,7 +884,12 @@ func RatedMovies(movies []api.RatedMovie, asJSON bool) {
 
 func RatedTVShows(shows []api.RatedTV, asJSON bool) {
 	if asJSON {
-		printJSON(shows)
+		withPosters := make([]api.RatedTV, len(shows))
+		for i, s := range shows {
+			s.PosterURL = posterURL(s.PosterPath)
+			withPosters[i] = s
+		}
+		printJSON(withPosters)
 		return
 	}
 	for i, s := range shows {
@@ -125,6 +903,115 @@ func RatedTVShows(shows []api.RatedTV, asJSON bool) {
 	}
 }
 
+// Pagination prints a page footer for paged listings.
+func Pagination(page, totalPages int, asJSON bool) {
+	if asJSON || totalPages <= 1 {
+		return
+	}
+	fmt.Printf("\nPage %d of %d (use --page N for more)\n", page, totalPages)
+}
+
+// ReleaseWindow prints the date range a now-playing or upcoming list covers.
+func ReleaseWindow(dates *api.DateRange, region string, asJSON bool) {
+	if asJSON || dates == nil {
+		return
+	}
+	if region == "" {
+		region = "all regions"
+	}
+	fmt.Printf("Release window %s – %s (%s)\n\n", dates.Minimum, dates.Maximum, region)
+}
+
+// Images prints images with kind, resolution, language and URL at size
+// (falling back to "original" where size is not offered for a kind).
+func Images(images []api.TypedImage, size string, asJSON bool) {
+	if asJSON {
+		out := make([]imageJSON, len(images))
+		for i, img := range images {
+			out[i] = imageJSON{img, ImageURL(img.Kind, img.FilePath, size)}
+		}
+		printJSON(out)
+		return
+	}
+	for i, img := range images {
+		lang := img.Language
+		if lang == "" {
+			lang = "no text"
+		}
+		fmt.Printf("%d. [%s] %dx%d %s ★%.1f\n   %s\n", i+1, img.Kind, img.Width, img.Height, lang, img.VoteAverage, ImageURL(img.Kind, img.FilePath, size))
+	}
+}
+
+// DownloadResult describes one downloaded image file.
+type DownloadResult struct {
+	File   string `json:"file"`
+	URL    string `json:"url"`
+	Status string `json:"status"` // saved, updated, unchanged or an error message
+}
+
+func Downloads(results []DownloadResult, asJSON bool) {
+	if asJSON {
+		printJSON(results)
+		return
+	}
+	for _, r := range results {
+		fmt.Printf("%-9s %s\n", r.Status, r.File)
+	}
+}
+
+type imageJSON struct {
+	api.TypedImage
+	URL string `json:"url"`
+}
+
+// imageConfig returns the image base URL and sizes used for image links.
+var imageConfig = api.DefaultImageConfig
+
+// SetImageConfigLoader sets where the image configuration comes from. load
+// runs at most once, when the first image link is built, so commands that
+// print no image links never fetch it.
+func SetImageConfigLoader(load func() api.ImageConfig) {
+	imageConfig = sync.OnceValue(load)
+}
+
+// ImageURL builds the URL of an image of the given kind at size.
+func ImageURL(kind, filePath, size string) string {
+	return imageConfig().URL(filePath, ImageSize(kind, size))
+}
+
+// ImageSize returns the size ImageURL fetches for kind: size when TMDB offers
+// it, otherwise "original".
+func ImageSize(kind, size string) string {
+	return imageConfig().Size(kind, size)
+}
+
+// posterURL returns "" for titles without a poster, without loading the
+// image configuration.
+func posterURL(path string) string {
+	if path == "" {
+		return ""
+	}
+	return ImageURL("poster", path, "w500")
+}
+
+func moviesWithPosters(movies []api.MovieResult) []api.MovieResult {
+	withPosters := make([]api.MovieResult, len(movies))
+	for i, m := range movies {
+		m.PosterURL = posterURL(m.PosterPath)
+		withPosters[i] = m
+	}
+	return withPosters
+}
+
+func showsWithPosters(shows []api.TVResult) []api.TVResult {
+	withPosters := make([]api.TVResult, len(shows))
+	for i, s := range shows {
+		s.PosterURL = posterURL(s.PosterPath)
+		withPosters[i] = s
+	}
+	return withPosters
+}
+
 func tmdbURL(mediaType string, id int) string {
 	return fmt.Sprintf("https://www.themoviedb.org/%s/%d", mediaType, id)
 }
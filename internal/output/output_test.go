@@ -1,7 +1,11 @@
 package output
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
+
+	"github.com/yareeh/themoviedb-cli/internal/api"
 )
 
 func TestYearFrom(t *testing.T) {
@@ -93,3 +97,125 @@ func TestWrap(t *testing.T) {
 		})
 	}
 }
+
+func TestExternalIDPairs(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  api.ExternalIDs
+		want string
+	}{
+		{"movie", api.ExternalIDs{ID: 603, IMDbID: "tt0133093", WikidataID: "Q83495"}, " imdb:tt0133093 wikidata:Q83495"},
+		{"tv", api.ExternalIDs{ID: 1396, IMDbID: "tt0903747", TVDBID: 81189}, " imdb:tt0903747 tvdb:81189"},
+		{"empty", api.ExternalIDs{ID: 1}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := externalIDPairs(tt.ids); got != tt.want {
+				t.Errorf("externalIDPairs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPosterURL(t *testing.T) {
+	SetImageConfigLoader(api.DefaultImageConfig)
+	if got := posterURL("/p.jpg"); got != "https://image.tmdb.org/t/p/w500/p.jpg" {
+		t.Errorf("posterURL = %q", got)
+	}
+	SetImageConfigLoader(func() api.ImageConfig {
+		t.Error("image configuration loaded for an empty poster path")
+		return api.DefaultImageConfig()
+	})
+	if got := posterURL(""); got != "" {
+		t.Errorf("posterURL(\"\") = %q", got)
+	}
+	SetImageConfigLoader(api.DefaultImageConfig)
+	movies := moviesWithPosters([]api.MovieResult{{ID: 603, PosterPath: "/p.jpg"}, {ID: 1}})
+	if movies[0].PosterURL == "" || movies[1].PosterURL != "" {
+		t.Errorf("moviesWithPosters = %+v", movies)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		n    int
+		want string
+	}{
+		{"short", "hello world", 80, "hello world"},
+		{"word boundary", "hello wonderful world", 12, "hello …"},
+		{"no space", "superlongword", 5, "super …"},
+		{"multibyte", "Åsa Törnqvist är här", 7, "Åsa …"},
+		{"multibyte no space", "ääääää", 3, "äää …"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := truncate(tt.text, tt.n); got != tt.want {
+				t.Errorf("truncate(%q, %d) = %q, want %q", tt.text, tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPick(t *testing.T) {
+	choices := []string{"[movie:841] Dune (1984)", "[movie:438631] Dune (2021)"}
+	tests := []struct {
+		input   string
+		want    int
+		wantErr bool
+	}{
+		{"2\n", 1, false},
+		{"x\n3\n1\n", 0, false},
+		{"1", 0, false},
+		{"\n", 0, true},
+		{"", 0, true},
+		{"0\n", 0, true},
+	}
+	for _, tt := range tests {
+		var out strings.Builder
+		got, err := Pick(strings.NewReader(tt.input), &out, "Which one?", choices)
+		if tt.wantErr {
+			if err != ErrNoChoice {
+				t.Errorf("Pick(%q) error = %v, want ErrNoChoice", tt.input, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("Pick(%q) = %d, %v, want %d", tt.input, got, err, tt.want)
+		}
+		if !strings.Contains(out.String(), "2. [movie:438631] Dune (2021)") {
+			t.Errorf("Pick(%q) did not list the choices:\n%s", tt.input, out.String())
+		}
+	}
+}
+
+func TestCandidateLabel(t *testing.T) {
+	r := api.MultiResult{MediaType: "movie", Movie: &api.MovieResult{
+		ID: 438631, Title: "Dune", ReleaseDate: "2021-09-15", VoteAverage: 7.8,
+		Overview: "Paul Atreides travels to Arrakis. Much happens there.",
+	}}
+	want := "[movie:438631] Dune (2021) ★7.8\n   Paul Atreides travels to Arrakis."
+	if got := CandidateLabel(r); got != want {
+		t.Errorf("CandidateLabel() = %q, want %q", got, want)
+	}
+}
+
+func TestMovieDetailsJSON(t *testing.T) {
+	var m api.MovieFullDetails
+	raw := `{"id":603,"title":"The Matrix","release_dates":{"results":[{"iso_3166_1":"FI","release_dates":[
+		{"certification":"K16","release_date":"1999-06-11T00:00:00.000Z","type":3}]}]}}`
+	if err := json.Unmarshal([]byte(raw), &m); err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(movieDetailsJSON{m, "FI", m.Certification("FI"), m.ReleaseDates.ForRegion("FI")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"id":603`, `"region":"FI"`, `"certification":"K16"`, `"region_release_dates":[{"certification":"K16"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("JSON lacks %s: %s", want, data)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package output
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/yareeh/themoviedb-cli/internal/api"
+)
+
+// ErrNoChoice is returned by Pick when the user answers with an empty line
+// or closes the input instead of choosing.
+var ErrNoChoice = errors.New("nothing chosen")
+
+// Interactive reports whether stdin and stdout are both terminals, so a
+// prompt can be shown and answered. Piped and scripted runs never prompt.
+func Interactive() bool {
+	return isTerminal(os.Stdin) && isTerminal(os.Stdout)
+}
+
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// Pick writes the choices to out numbered from 1, asks question and reads
+// answers from in until one is a valid number. It returns the chosen index
+// (from 0), or ErrNoChoice for an empty answer or end of input.
+func Pick(in io.Reader, out io.Writer, question string, choices []string) (int, error) {
+	for i, c := range choices {
+		fmt.Fprintf(out, "%d. %s\n", i+1, c)
+	}
+	r := bufio.NewReader(in)
+	for {
+		fmt.Fprintf(out, "%s [1-%d, Enter to cancel]: ", question, len(choices))
+		line, err := r.ReadString('\n')
+		answer := strings.TrimSpace(line)
+		if answer == "" {
+			if err != nil && err != io.EOF {
+				return 0, err
+			}
+			return 0, ErrNoChoice
+		}
+		if n, convErr := strconv.Atoi(answer); convErr == nil && n >= 1 && n <= len(choices) {
+			return n - 1, nil
+		}
+		fmt.Fprintf(out, "Enter a number from 1 to %d.\n", len(choices))
+		if err != nil {
+			return 0, ErrNoChoice
+		}
+	}
+}
+
+// CandidateLabel describes a search match for Pick: its reference, title,
+// year and rating, and the start of its overview on a second line.
+func CandidateLabel(r api.MultiResult) string {
+	switch {
+	case r.Movie != nil:
+		m := r.Movie
+		return candidateLine(r.Key(), m.Title, yearFrom(m.ReleaseDate), m.VoteAverage, m.Overview)
+	case r.TV != nil:
+		s := r.TV
+		return candidateLine(r.Key(), s.Name, yearFrom(s.FirstAirDate), s.VoteAverage, s.Overview)
+	case r.Person != nil:
+		p := r.Person
+		return fmt.Sprintf("[%s] %s (%s)", r.Key(), p.Name, p.KnownForDepartment)
+	}
+	return r.Key()
+}
+
+func candidateLine(key, title, year string, rating float64, overview string) string {
+	line := fmt.Sprintf("[%s] %s (%s) ★%.1f", key, title, year, rating)
+	if overview != "" {
+		line += "\n   " + truncate(firstSentence(overview), 100)
+	}
+	return line
+}
+
+// PickCandidate asks on the terminal which of the matches for query was
+// meant.
+func PickCandidate(query string, cands []api.MultiResult) (api.MultiResult, error) {
+	labels := make([]string, len(cands))
+	for i, c := range cands {
+		labels[i] = CandidateLabel(c)
+	}
+	fmt.Printf("Several matches for %q:\n", query)
+	i, err := Pick(os.Stdin, os.Stdout, "Which one?", labels)
+	if err != nil {
+		return api.MultiResult{}, err
+	}
+	return cands[i], nil
+}
@@ -1,97 +1,301 @@
 package main
 
 import (
+	"bufio"
+	"crypto/sha256"
 	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"strings"
 	"time"
 
 	"github.com/yareeh/themoviedb-cli/internal/api"
+	"github.com/yareeh/themoviedb-cli/internal/cli"
 	"github.com/yareeh/themoviedb-cli/internal/config"
 	"github.com/yareeh/themoviedb-cli/internal/output"
 )
 
 func main() {
-	if len(os.Args) < 2 {
-		printUsage()
-		os.Exit(1)
-	}
+	exitOnErr(app.Run(os.Args[1:]))
+}
 
-	cmd := os.Args[1]
-	args := os.Args[2:]
-	jsonFlag := hasFlag(&args, "--json")
+// Flags shared by several commands.
+var (
+	pageFlag    = cli.Flag{Name: "page", Value: "N", Usage: "Page of results"}
+	regionFlag  = cli.Flag{Name: "region", Value: "FI", Usage: "Region (ISO 3166-1, default US)"}
+	langFlag    = cli.Flag{Name: "lang", Value: "fi", Usage: "Language (ISO 639-1)"}
+	resolveFlag = cli.Flag{Name: "resolve", Usage: "Treat the argument as a title even if it looks like an ID"}
+	groupFlag   = cli.Flag{Name: "group", Value: "<id>", Usage: "Read S01E02 in an episode group's ordering; seasons are\nthe group's parts in order"}
+)
 
-	switch cmd {
-	case "login":
-		doLogin()
-	case "logout":
-		doLogout()
-	case "search":
-		doSearch(args, jsonFlag)
-	case "filmography":
-		doFilmography(args, jsonFlag)
-	case "rate":
-		doRate(args)
-	case "unrate":
-		doUnrate(args)
-	case "watchlist":
-		doWatchlist(args, jsonFlag)
-	case "seasons":
-		doSeasons(args, jsonFlag)
-	case "episodes":
-		doEpisodes(args, jsonFlag)
-	case "rated":
-		doRated(args, jsonFlag)
-	case "info":
-		doInfo(args)
-	case "help", "--help", "-h":
-		printUsage()
-	default:
-		fmt.Fprintf(os.Stderr, "Unknown command: %s\n", cmd)
-		printUsage()
-		os.Exit(1)
-	}
+// chartCommand is a chart listing; they all share doChart.
+func chartCommand(name, args, summary string, flags ...cli.Flag) *cli.Command {
+	return &cli.Command{Name: name, Args: args, Summary: summary, Flags: append(flags, pageFlag), Run: doChart}
 }
 
-func printUsage() {
-	fmt.Print(`themoviedb-cli — TMDB client for agents
+var app = &cli.App{
+	Name:    "themoviedb-cli",
+	Summary: "themoviedb-cli — TMDB client for agents",
+	Flags:   []cli.Flag{{Name: "json", Usage: "Output as JSON instead of text"}},
+	Before:  func(ctx *cli.Context) { jsonErrors = ctx.Bool("json") },
+	Commands: []*cli.Command{
+		{Name: "login", Summary: "Authenticate with TMDB\n(--lists: allow editing lists, needed once before list changes)",
+			Flags: []cli.Flag{{Name: "lists", Usage: "Get the user access token TMDB's list API needs for edits"}},
+			Run: func(ctx *cli.Context) {
+				if ctx.Bool("lists") {
+					doLoginLists()
+				} else {
+					doLogin()
+				}
+			}},
+		{Name: "logout", Summary: "Remove saved credentials", Run: func(*cli.Context) { doLogout() }},
+		{Name: "search", Args: "<query>", Summary: "Search movies, TV and people (narrow with movie:, tv:,\nperson:, collection:, company:, keyword:)",
+			Help: `Filters can also be written inline: "movie:Dune y:2021 lang:fi region:FI".`,
+			Flags: []cli.Flag{
+				{Name: "year", Value: "Y", Usage: "Movies released or shows aired in year Y"},
+				{Name: "release-year", Value: "Y", Usage: "Movies first released in year Y"},
+				{Name: "first-air-year", Value: "Y", Usage: "Shows first aired in year Y"},
+				langFlag, regionFlag,
+				{Name: "adult", Usage: "Include adult titles"},
+				pageFlag,
+			},
+			Run: doSearch},
+		{Name: "find", Args: "<source> <external_id>", Summary: "Find by IMDb, TVDB, Wikidata... ID",
+			Help: "<source> is imdb, tvdb, wikidata, facebook, instagram, twitter, tiktok or youtube.", Run: doFind},
+		{Name: "ids", Args: "<movie|tv|person|episode> <ref|->", Summary: "External IDs (IMDb, TVDB, Wikidata...); - reads\nreferences from stdin",
+			Help: "Use - to read one reference per line from stdin (\"<series_id> S01E02\" for episodes\nafter \"ids episode -\").", Run: doIDs},
+		{Name: "filmography", Args: "<person>", Summary: "List filmography of a person", Flags: []cli.Flag{resolveFlag}, Run: doFilmography},
+		{Name: "rate", Args: "<ref> <rating>", Summary: "Rate a movie, show or episode (1-10)",
+			Help:  "<ref> is movie:603, tv:1396/S05E16, \"movie 603\", \"episode 1396 S05E16\", a URL or a title.",
+			Flags: []cli.Flag{groupFlag, resolveFlag}, Run: doRate},
+		{Name: "unrate", Args: "<ref>", Summary: "Remove a rating",
+			Help:  "<ref> is movie:603, tv:1396/S05E16, \"movie 603\", \"episode 1396 S05E16\", a URL or a title.",
+			Flags: []cli.Flag{groupFlag, resolveFlag}, Run: doUnrate},
+		{Name: "watchlist", Summary: "Manage watchlist", Run: doWatchlist, Subcommands: []*cli.Command{
+			{Name: "add", Args: "<ref>", Summary: "Add a movie or show", Flags: []cli.Flag{resolveFlag}},
+			{Name: "remove", Args: "<ref>", Summary: "Remove a movie or show", Flags: []cli.Flag{resolveFlag}},
+			{Name: "list", Args: "[movie|tv]", Summary: "Watchlisted titles",
+				Flags: []cli.Flag{
+					{Name: "available-on", Value: "netflix,yle", Usage: "Only titles streaming on these providers"},
+					regionFlag,
+				}},
+		}},
+		{Name: "favorite", Summary: "Manage favorites", Run: doFavorite, Subcommands: []*cli.Command{
+			{Name: "add", Args: "<ref>", Summary: "Add a movie or show"},
+			{Name: "remove", Args: "<ref>", Summary: "Remove a movie or show"},
+			{Name: "list", Args: "[movie|tv]", Summary: "Favorite titles"},
+		}},
+		{Name: "changes", Args: "[movie|tv]", Summary: "What changed on your watchlist and rated titles",
+			Flags: []cli.Flag{
+				{Name: "days", Value: "N", Usage: "Look back N days (default 7)"},
+				{Name: "from", Value: "YYYY-MM-DD", Usage: "Start of the window"},
+				{Name: "to", Value: "YYYY-MM-DD", Usage: "End of the window (default today)"},
+				{Name: "list", Value: "watchlist,rated", Usage: "Lists to check"},
+				{Name: "keys", Value: "release_dates,season", Usage: "Only these kinds of change"},
+			},
+			Run: doChanges,
+			Subcommands: []*cli.Command{
+				{Name: "ids", Args: "<movie|tv|person>", Summary: "Everything changed on TMDB (14 days max)",
+					Flags: []cli.Flag{
+						{Name: "days", Value: "N", Usage: "Look back N days (default 7)"},
+						{Name: "from", Value: "YYYY-MM-DD", Usage: "Start of the window"},
+						{Name: "to", Value: "YYYY-MM-DD", Usage: "End of the window (default today)"},
+						pageFlag,
+					}},
+			}},
+		{Name: "ref", Args: "<countries|languages|timezones|jobs|translations> [filter]", Summary: "Codes TMDB accepts", Run: doRef},
+		{Name: "list", Summary: "Manage your TMDB lists", Run: doList, Subcommands: []*cli.Command{
+			{Name: "mine", Summary: "Your TMDB lists", Flags: []cli.Flag{pageFlag}},
+			{Name: "show", Args: "<list_id>", Summary: "Items on a list with comments", Flags: []cli.Flag{pageFlag}},
+			{Name: "create", Args: "<name>", Summary: "New list",
+				Flags: []cli.Flag{
+					{Name: "description", Value: "text", Usage: "List description"},
+					{Name: "public", Usage: "Make the list public"},
+					langFlag,
+				}},
+			{Name: "add", Args: "<list_id> <ref>...", Summary: "Add items",
+				Flags: []cli.Flag{{Name: "comment", Value: "text", Usage: "Comment on the added items"}}},
+			{Name: "remove", Args: "<list_id> <ref>...", Summary: "Remove items"},
+			{Name: "comment", Args: "<list_id> <ref>...", Summary: "Change items' comment",
+				Flags: []cli.Flag{{Name: "comment", Value: "text", Usage: "The new comment (required; \"\" clears it)"}}},
+			{Name: "update", Args: "<list_id>", Summary: "Edit a list's details",
+				Flags: []cli.Flag{
+					{Name: "name", Value: "text", Usage: "New name"},
+					{Name: "description", Value: "text", Usage: "New description"},
+					{Name: "public", Usage: "Make the list public"},
+					{Name: "private", Usage: "Make the list private"},
+					{Name: "sort", Value: "original_order.asc", Usage: "Item order"},
+					langFlag,
+				}},
+			{Name: "clear", Args: "<list_id>", Summary: "Remove every item"},
+			{Name: "delete", Args: "<list_id>", Summary: "Delete a list"},
+		}},
+		{Name: "discover", Args: "[movie|tv]", Summary: "Find titles by genre, year, rating, language...",
+			Flags: []cli.Flag{
+				{Name: "genre", Value: "a,b", Usage: "Genre names or IDs"},
+				{Name: "without-genre", Value: "a,b", Usage: "Exclude genres"},
+				{Name: "keyword", Value: "names|ids", Usage: "Keyword names or IDs"},
+				{Name: "year", Value: "1990-1999", Usage: "Release year or range"},
+				{Name: "min-rating", Value: "N", Usage: "Minimum average rating"},
+				{Name: "max-rating", Value: "N", Usage: "Maximum average rating"},
+				{Name: "min-votes", Value: "N", Usage: "Minimum number of votes"},
+				{Name: "min-runtime", Value: "N", Usage: "Minimum runtime in minutes"},
+				{Name: "max-runtime", Value: "N", Usage: "Maximum runtime in minutes"},
+				{Name: "lang", Value: "fi", Usage: "Original language (ISO 639-1)"},
+				{Name: "cert", Value: "K-12", Usage: "Certification (movies only)"},
+				{Name: "cert-country", Value: "FI", Usage: "Country of --cert"},
+				{Name: "provider", Value: "8,337", Usage: "Watch provider IDs in --region"},
+				regionFlag,
+				{Name: "company", Value: "ids", Usage: "Production company IDs"},
+				{Name: "person", Value: "ids", Usage: "Cast or crew person IDs"},
+				{Name: "sort", Value: "popularity.desc", Usage: "Sort order"},
+				pageFlag,
+			},
+			Run: doDiscover},
+		chartCommand("trending", "[movie|tv|person|all]", "Trending titles or people",
+			cli.Flag{Name: "window", Value: "day|week", Usage: "Trending window (default day)"}),
+		chartCommand("popular", "[movie|tv|person]", "Popular titles or people", regionFlag),
+		chartCommand("top", "[movie|tv]", "Top rated titles", regionFlag),
+		chartCommand("upcoming", "", "Upcoming movie releases", regionFlag),
+		chartCommand("now-playing", "", "Movies in theatres", regionFlag),
+		chartCommand("airing-today", "", "TV airing today"),
+		chartCommand("on-the-air", "", "TV airing in the next 7 days"),
+		{Name: "recommend", Args: "<ref>", Summary: "Recommendations based on a title", Flags: recommendFlags, Run: doRecommend},
+		{Name: "similar", Args: "<ref>", Summary: "Titles similar to a title", Flags: recommendFlags, Run: doRecommend},
+		{Name: "providers", Args: "<ref>", Summary: "Where to watch", Flags: []cli.Flag{regionFlag}, Run: doProviders,
+			Subcommands: []*cli.Command{
+				{Name: "list", Args: "[movie|tv]", Summary: "Watch provider catalogue", Flags: []cli.Flag{regionFlag}},
+				{Name: "regions", Summary: "Regions with watch provider data"},
+			}},
+		{Name: "videos", Args: "<ref> [S01|S01E02]", Summary: "Trailers, teasers, clips",
+			Flags: []cli.Flag{
+				{Name: "trailer", Usage: "Only the best official trailer's URL"},
+				{Name: "type", Value: "Teaser", Usage: "Only videos of this type"},
+				{Name: "lang", Value: "fi", Usage: "Preferred language, falling back to English"},
+			},
+			Run: doVideos},
+		{Name: "images", Args: "<ref>", Summary: "Posters, backdrops, logos, profiles with URLs",
+			Flags: []cli.Flag{
+				{Name: "type", Value: "poster", Usage: "poster, backdrop, logo, profile or still"},
+				{Name: "lang", Value: "en|none", Usage: "Image language; none for textless images"},
+				{Name: "min-width", Value: "N", Usage: "Minimum width in pixels"},
+				{Name: "size", Value: "w500", Usage: "Size in the URLs (default original)"},
+			},
+			Run: doImages,
+			Subcommands: []*cli.Command{
+				{Name: "download", Args: "<ref> [S01E02]", Summary: "Save artwork; --from saves it for a whole list",
+					Flags: []cli.Flag{
+						{Name: "type", Value: "poster", Usage: "poster, backdrop, logo, profile or still"},
+						{Name: "lang", Value: "en|none", Usage: "Image language; none for textless images"},
+						{Name: "size", Value: "w780", Usage: "Size to download (default original)"},
+						{Name: "dir", Value: "DIR", Usage: "Where to save (default .)"},
+						{Name: "from", Value: "watchlist|rated|favorite", Usage: "Download for every title on a list"},
+					},
+					Run: doImagesDownload},
+			}},
+		{Name: "credits", Args: "<ref> [S01]", Summary: "Cast and crew; TV shows episode counts across seasons",
+			Flags: []cli.Flag{
+				{Name: "cast", Usage: "Only the cast"},
+				{Name: "crew", Usage: "Only the crew"},
+				{Name: "department", Value: "Writing", Usage: "Only crew in this department"},
+			},
+			Run: doCredits},
+		{Name: "reviews", Args: "<ref> | <review_id>", Summary: "User reviews, or a single review in full",
+			Flags: []cli.Flag{{Name: "full", Usage: "Complete review text"}, pageFlag},
+			Run:   doReviews},
+		{Name: "collection", Args: "<id>", Summary: "Movies in a collection, in release order", Flags: []cli.Flag{pageFlag}, Run: doBrowse},
+		{Name: "company", Args: "<id>", Summary: "Company details and its movies", Flags: []cli.Flag{pageFlag}, Run: doBrowse},
+		{Name: "network", Args: "<id>", Summary: "TV network details and its shows", Flags: []cli.Flag{pageFlag}, Run: doBrowse},
+		{Name: "keyword", Args: "<id>", Summary: "Movies tagged with a keyword", Flags: []cli.Flag{pageFlag}, Run: doBrowse},
+		{Name: "info", Args: "<ref>", Summary: "Details with genres, keywords, age rating, release dates\nand local titles",
+			Help:  "Prints the full details as JSON with the region's certification and release\ndates added; --text prints a readable summary instead.",
+			Flags: []cli.Flag{regionFlag, {Name: "text", Usage: "Readable summary instead of JSON"}}, Run: doInfo},
+		{Name: "genres", Args: "[movie|tv]", Summary: "Genre names and IDs for discover --genre", Run: doGenres},
+		{Name: "certifications", Args: "[movie|tv]", Summary: "Age rating systems", Flags: []cli.Flag{regionFlag}, Run: doCertifications},
+		{Name: "seasons", Args: "<series>", Summary: "List seasons of a TV series", Flags: []cli.Flag{resolveFlag}, Run: doSeasons},
+		{Name: "episodes", Args: "<series> <season>", Summary: "List episodes of a season",
+			Help: "A season reference such as tv:1396/S05 works too.", Flags: []cli.Flag{groupFlag, resolveFlag}, Run: doEpisodes},
+		{Name: "episode", Args: "<series> S01E02", Summary: "Episode details",
+			Help: "An episode reference such as tv:1396/S05E16 works too.", Flags: []cli.Flag{groupFlag}, Run: doEpisode},
+		{Name: "episode-groups", Args: "<series>", Summary: "Alternate orderings (DVD, absolute, story arc...)", Run: doEpisodeGroups},
+		{Name: "rated", Args: "[movie|tv] [all|ytd|last N|from YYYY-MM-DD]", Summary: "List rated", Run: doRated},
+	},
+	Footer: `Region (--region, --cert-country) and language (--lang) codes are checked
+against TMDB's reference data; see "ref countries" and "ref languages".
 
-Usage: themoviedb-cli <command> [options]
+IDs: anywhere a title or person is expected you can write movie:603, tv:1396,
+tv:1396/S05E16, [movie:603] as printed in listings, a themoviedb.org URL or an
+IMDb ID (tt0133093, nm0000093). "<movie|tv> <id>" still works.
 
-Commands:
-  login                          Authenticate with TMDB
-  logout                         Remove saved credentials
-  search <query>                 Search movies, TV, people (prefix: movie:, tv:, person:)
-  filmography <person_id>        List filmography of a person
-  rate <movie|tv|episode> <id> <rating>  Rate (1-10, use S01E02 format for episodes)
-  unrate <movie|tv|episode> <id>        Remove a rating
-  watchlist <add|remove|list> [movie|tv] [id]  Manage watchlist
-  seasons <series_id>            List seasons of a TV series
-  episodes <series_id> <season>  List episodes of a season
-  rated [movie|tv] [all|ytd|last N|from YYYY-MM-DD]  List rated
-
-Options:
-  --json    Output as JSON instead of text
+Titles: anything else is searched as a title, "The Matrix (1999)" narrowing by
+year. An exact title (and year, if given) is used directly when it's the only
+one or far more popular than the others. Otherwise a picker comes up on a
+terminal, or the command exits with status 2 listing the candidates (as JSON
+with --json).
 
 Examples:
   themoviedb-cli search "The Matrix"
   themoviedb-cli search "tv:Breaking Bad"
   themoviedb-cli search "person:Brad Pitt"
+  themoviedb-cli search "movie:Dune y:2021"
+  themoviedb-cli search "collection:The Matrix"
+  themoviedb-cli collection 2344
+  themoviedb-cli find imdb tt0133093
+  themoviedb-cli ids tv 1396
+  printf '603\n604\n' | themoviedb-cli ids movie -
   themoviedb-cli filmography 287
+  themoviedb-cli rate movie tt0133093 9
   themoviedb-cli rate movie 603 9
   themoviedb-cli rate episode 1396 S05E16 10
+  themoviedb-cli rate tv:1396/S05E16 10
+  themoviedb-cli rate movie "The Matrix (1999)" 9
+  themoviedb-cli seasons "Breaking Bad"
+  themoviedb-cli watchlist add movie:603
   themoviedb-cli watchlist add movie 603
   themoviedb-cli watchlist list
+  themoviedb-cli watchlist list --available-on netflix,yle --region FI
+  themoviedb-cli providers movie 603 --region FI
+  themoviedb-cli favorite add tv 1396
+  themoviedb-cli favorite list tv
+  themoviedb-cli ref countries fin
+  themoviedb-cli changes movie --list watchlist --keys release_dates --days 30
+  themoviedb-cli list create "Movie night: heists" --description "Friday picks"
+  themoviedb-cli list add 8291 movie 949 tv 1396 --comment "Start here"
+  themoviedb-cli discover movie --lang fi --genre drama --year 1990-1999 --min-rating 7
+  themoviedb-cli trending tv --window week
+  themoviedb-cli upcoming --region FI
+  themoviedb-cli recommend movie 603 --hide-rated
+  themoviedb-cli info movie 603 --region FI
+  themoviedb-cli certifications movie --region FI
+  themoviedb-cli genres tv
+  themoviedb-cli videos movie 603 --trailer
+  themoviedb-cli videos tv 1396 S05
+  themoviedb-cli images movie 603 --type poster --lang en --size w500
+  themoviedb-cli images download --from watchlist movie --size w780 --dir posters
+  themoviedb-cli reviews movie 603 --page 2
+  themoviedb-cli credits movie 603 --department directing
   themoviedb-cli seasons 1396
   themoviedb-cli episodes 1396 5
+  themoviedb-cli episode-groups 46260
+  themoviedb-cli rate episode 46260 S01E05 8 --group <group_id>
   themoviedb-cli rated movie
   themoviedb-cli rated movie ytd
   themoviedb-cli rated movie last 10
   themoviedb-cli rated tv from 2025-06-01
-`)
+`,
+}
+
+var recommendFlags = []cli.Flag{
+	{Name: "hide-rated", Usage: "Skip titles you have already rated"},
+	{Name: "hide-watchlist", Usage: "Skip titles already on your watchlist"},
+	pageFlag,
 }
 
 func mustClient() *api.Client {
@@ -111,7 +315,40 @@ func mustClient() *api.Client {
 			_ = config.Save(cfg)
 		}
 	}
-	return api.New(cfg.AccessToken, cfg.SessionID, cfg.AccountID, cfg.AccountObjectID)
+	client := api.New(cfg.AccessToken, cfg.SessionID, cfg.AccountID, cfg.AccountObjectID)
+	client.SetUserAccessToken(cfg.UserAccessToken)
+	output.SetImageConfigLoader(func() api.ImageConfig { return loadImageConfig(client) })
+	return client
+}
+
+// imageConfigMaxAge is how long the /configuration response is cached.
+const imageConfigMaxAge = 72 * time.Hour
+
+// imageConfigRetry is how long a failed /configuration request is remembered
+// before it is tried again.
+const imageConfigRetry = time.Hour
+
+// loadImageConfig returns the cached image configuration, refreshing it when
+// stale. Errors fall back to TMDB's default image settings.
+func loadImageConfig(client *api.Client) api.ImageConfig {
+	var ic api.ImageConfig
+	if config.ReadCache("configuration", imageConfigMaxAge, &ic) {
+		return ic
+	}
+	var failure string
+	if config.ReadCache("configuration-error", imageConfigRetry, &failure) {
+		return api.DefaultImageConfig()
+	}
+	resp, err := client.Configuration()
+	if err == nil && resp.Images.SecureBaseURL == "" {
+		err = fmt.Errorf("no image base URL in configuration")
+	}
+	if err != nil {
+		_ = config.WriteCache("configuration-error", err.Error())
+		return api.DefaultImageConfig()
+	}
+	_ = config.WriteCache("configuration", resp.Images)
+	return resp.Images
 }
 
 func doLogin() {
@@ -133,188 +370,2171 @@ func doLogin() {
 		os.Exit(1)
 	}
 
-	approveURL := fmt.Sprintf("https://www.themoviedb.org/authenticate/%s", reqToken)
-	fmt.Printf("\nOpen this URL to approve access:\n  %s\n\nPress Enter after approving...", approveURL)
-	fmt.Scanln()
+	approveURL := fmt.Sprintf("https://www.themoviedb.org/authenticate/%s", reqToken)
+	fmt.Printf("\nOpen this URL to approve access:\n  %s\n\nPress Enter after approving...", approveURL)
+	fmt.Scanln()
+
+	// Create session
+	sessionID, err := client.CreateSession(reqToken)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating session: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Get account info
+	sessionClient := api.New(token, sessionID, 0, "")
+	account, err := sessionClient.GetAccount()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting account: %v\n", err)
+		os.Exit(1)
+	}
+
+	accountObjectID := extractJWTSub(token)
+
+	cfg := &config.Config{
+		AccessToken:     token,
+		SessionID:       sessionID,
+		AccountID:       account.ID,
+		AccountObjectID: accountObjectID,
+	}
+	if err := config.Save(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "Error saving config: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Logged in as %s (account %d)\n", account.Username, account.ID)
+}
+
+// doLoginLists gets a V4 user access token, which list editing requires, and
+// adds it to the existing login.
+func doLoginLists() {
+	cfg, err := config.Load()
+	exitOnErr(err)
+	if cfg.AccessToken == "" {
+		fmt.Fprintln(os.Stderr, "Not logged in. Run: themoviedb-cli login")
+		os.Exit(1)
+	}
+	client := api.New(cfg.AccessToken, "", 0, "")
+	reqToken, err := client.CreateV4RequestToken()
+	exitOnErr(err)
+
+	fmt.Printf("Open this URL to allow editing your lists:\n  %s\n\nPress Enter after approving...", api.V4ApproveURL(reqToken))
+	fmt.Scanln()
+
+	access, err := client.CreateV4AccessToken(reqToken)
+	exitOnErr(err)
+	cfg.UserAccessToken = access.AccessToken
+	if access.AccountID != "" {
+		cfg.AccountObjectID = access.AccountID
+	}
+	if err := config.Save(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "Error saving config: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println("List editing enabled.")
+}
+
+func doLogout() {
+	path := config.Path()
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Error removing config: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println("Logged out. Credentials removed.")
+}
+
+func doSearch(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	year := ctx.String("year")
+	releaseYear := ctx.String("release-year")
+	firstAirYear := ctx.String("first-air-year")
+	region := ctx.String("region")
+	lang := ctx.String("lang")
+	page := ctx.String("page")
+	adult := ctx.Bool("adult")
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
+	kind, query, opts, err := parseSearchQuery(strings.Join(args, " "))
+	exitOnErr(err)
+	if year != "" {
+		opts.Year = parseIntFlag("--year", year)
+	}
+	if releaseYear != "" {
+		opts.PrimaryReleaseYear = parseIntFlag("--release-year", releaseYear)
+	}
+	if firstAirYear != "" {
+		opts.FirstAirDateYear = parseIntFlag("--first-air-year", firstAirYear)
+	}
+	if region != "" {
+		opts.Region = region
+	}
+	if lang != "" {
+		opts.Language = lang
+	}
+	if page != "" {
+		opts.Page = parseIntFlag("--page", page)
+	}
+	opts.IncludeAdult = opts.IncludeAdult || adult
+	if opts.PrimaryReleaseYear > 0 && kind != "movie" {
+		exitOnErr(fmt.Errorf("--release-year only applies to movie searches (movie:%s)", query))
+	}
+	if opts.FirstAirDateYear > 0 && kind != "tv" {
+		exitOnErr(fmt.Errorf("--first-air-year only applies to TV searches (tv:%s)", query))
+	}
+	client := mustClient()
+	opts.Region = mustRegion(client, "--region", opts.Region)
+	opts.Language = mustLanguage(client, "--lang", opts.Language)
+
+	switch kind {
+	case "tv":
+		resp, err := client.SearchTV(query, opts)
+		exitOnErr(err)
+		output.TVShows(resp.Results, jsonFlag)
+		output.Pagination(resp.Page, resp.TotalPages, jsonFlag)
+
+	case "person":
+		resp, err := client.SearchPerson(query, opts)
+		exitOnErr(err)
+		output.People(resp.Results, jsonFlag)
+		output.Pagination(resp.Page, resp.TotalPages, jsonFlag)
+
+	case "movie":
+		resp, err := client.SearchMovies(query, opts)
+		exitOnErr(err)
+		output.Movies(resp.Results, jsonFlag)
+		output.Pagination(resp.Page, resp.TotalPages, jsonFlag)
+
+	case "collection":
+		resp, err := client.SearchCollections(query, opts)
+		exitOnErr(err)
+		output.Collections(resp.Results, jsonFlag)
+		output.Pagination(resp.Page, resp.TotalPages, jsonFlag)
+
+	case "company":
+		resp, err := client.SearchCompanies(query, opts)
+		exitOnErr(err)
+		output.Companies(resp.Results, jsonFlag)
+		output.Pagination(resp.Page, resp.TotalPages, jsonFlag)
+
+	case "keyword":
+		resp, err := client.SearchKeywords(query, opts)
+		exitOnErr(err)
+		output.Keywords(resp.Results, jsonFlag)
+		output.Pagination(resp.Page, resp.TotalPages, jsonFlag)
+
+	default:
+		// Default: search movies, TV and people together
+		resp, err := client.SearchMulti(query, opts)
+		exitOnErr(err)
+		output.Multi(filterMultiByYear(resp.Results, opts.Year), jsonFlag)
+		output.Pagination(resp.Page, resp.TotalPages, jsonFlag)
+	}
+}
+
+// parseSearchQuery splits "movie:Dune y:2021 lang:fi" into the search type
+// prefix (movie, tv, person, collection, company, keyword or "" for all), the
+// query text and inline options.
+// Supported inline options: y: or year:, lang:, region:, adult:true.
+func parseSearchQuery(input string) (string, string, api.SearchOptions, error) {
+	var opts api.SearchOptions
+	kind := ""
+	input = strings.TrimSpace(input)
+	for _, prefix := range []string{"movie", "tv", "person", "collection", "company", "keyword"} {
+		if strings.HasPrefix(input, prefix+":") {
+			kind = prefix
+			input = strings.TrimSpace(strings.TrimPrefix(input, prefix+":"))
+			break
+		}
+	}
+
+	var words []string
+	for _, w := range strings.Fields(input) {
+		key, value, ok := strings.Cut(w, ":")
+		if !ok || value == "" {
+			words = append(words, w)
+			continue
+		}
+		switch strings.ToLower(key) {
+		case "y", "year":
+			year, err := strconv.Atoi(value)
+			if err != nil {
+				return "", "", opts, fmt.Errorf("invalid year %q", value)
+			}
+			opts.Year = year
+		case "lang":
+			opts.Language = value
+		case "region":
+			opts.Region = strings.ToUpper(value)
+		case "adult":
+			opts.IncludeAdult = value == "true" || value == "yes"
+		default:
+			words = append(words, w)
+		}
+	}
+	query := strings.Join(words, " ")
+	if query == "" {
+		return "", "", opts, fmt.Errorf("empty search query")
+	}
+	return kind, query, opts, nil
+}
+
+// filterMultiByYear keeps titles released in year (multi search has no
+// server-side year filter). People are always kept. Year 0 keeps everything.
+func filterMultiByYear(results []api.MultiResult, year int) []api.MultiResult {
+	if year == 0 {
+		return results
+	}
+	want := strconv.Itoa(year)
+	var filtered []api.MultiResult
+	for _, r := range results {
+		switch {
+		case r.Movie != nil && !strings.HasPrefix(r.Movie.ReleaseDate, want):
+		case r.TV != nil && !strings.HasPrefix(r.TV.FirstAirDate, want):
+		default:
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
+}
+
+func doFind(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	if len(args) < 2 {
+		usageExit(ctx)
+	}
+	source := strings.ToLower(args[0])
+	if _, ok := api.ExternalSources[source]; !ok {
+		fmt.Fprintf(os.Stderr, "Unknown source: %s\n", args[0])
+		os.Exit(1)
+	}
+	client := mustClient()
+	resp, err := client.FindByExternalID(source, args[1])
+	exitOnErr(err)
+	output.Found(resp, jsonFlag)
+}
+
+func doIDs(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
+	// A leading kind applies to every input, so bulk input can be bare IDs.
+	kind := ""
+	if args[0] == "episode" || slices.Contains([]string{"movie", "tv", "person"}, args[0]) {
+		kind, args = args[0], args[1:]
+	}
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
+	client := mustClient()
+
+	// Bulk mode: one reference per line on stdin, split into words. Otherwise
+	// the arguments are used as the shell quoted them.
+	var inputs [][]string
+	if args[len(args)-1] == "-" {
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line != "" && !strings.HasPrefix(line, "#") {
+				inputs = append(inputs, strings.Fields(line))
+			}
+		}
+		exitOnErr(scanner.Err())
+	} else {
+		inputs = [][]string{args}
+	}
+
+	var refs []string
+	var results []api.ExternalIDs
+	failed := false
+	for _, in := range inputs {
+		ref, ids, err := lookupExternalIDs(client, kind, in)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s: %v\n", strings.Join(in, " "), err)
+			failed = true
+			continue
+		}
+		refs = append(refs, ref.String())
+		results = append(results, *ids)
+	}
+	output.ExternalIDs(refs, results, jsonFlag)
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// lookupExternalIDs fetches the external IDs of one input reference, with
+// kind ("movie", "episode"...) put in front when given.
+func lookupExternalIDs(client *api.Client, kind string, args []string) (api.MediaRef, *api.ExternalIDs, error) {
+	if kind != "" {
+		args = append([]string{kind}, args...)
+	}
+	ref, rest, err := takeRef(client, args, "movie", "tv", "person")
+	if err != nil {
+		return ref, nil, err
+	}
+	if len(rest) > 0 {
+		return ref, nil, fmt.Errorf("unexpected %q", strings.Join(rest, " "))
+	}
+	var ids *api.ExternalIDs
+	switch {
+	case ref.Kind == "tv" && ref.Episode > 0:
+		ids, err = client.EpisodeExternalIDs(ref.ID, ref.Season, ref.Episode)
+	case ref.Kind == "tv" && ref.HasSeason:
+		err = fmt.Errorf("external IDs are per show or episode, not season")
+	case ref.Kind == "tv":
+		ids, err = client.TVExternalIDs(ref.ID)
+	case ref.Kind == "person":
+		ids, err = client.PersonExternalIDs(ref.ID)
+	default:
+		ids, err = client.MovieExternalIDs(ref.ID)
+	}
+	return ref, ids, err
+}
+
+// resolveID parses a reference to an entry of the given kind ("movie", "tv",
+// "person"...) and returns its TMDB ID. See takeRef for the accepted forms.
+func resolveID(client *api.Client, mediaType, arg string) (int, error) {
+	ref, _, err := takeRef(client, []string{arg}, mediaType)
+	return ref.ID, err
+}
+
+// takeRef reads a reference from the start of args and returns the rest.
+// It accepts a kind followed by an ID ("movie 603", "tv 1396 S05",
+// "episode 1396 S05E16") or a single reference: movie:603, tv:1396/S05E16,
+// a TMDB URL or an IMDb ID. A season code right after a TV reference is
+// taken as part of it. kinds lists what the command accepts; a bare ID is
+// only allowed when that leaves one choice. IMDb IDs are looked up via /find,
+// and anything else that isn't a reference is looked up as a title.
+func takeRef(client *api.Client, args []string, kinds ...string) (api.MediaRef, []string, error) {
+	return readRef(client, args, false, kinds...)
+}
+
+// refOnly reads a reference that must be the last argument, as for commands
+// that take nothing after it.
+func refOnly(client *api.Client, args []string, resolve bool, kinds ...string) (api.MediaRef, error) {
+	ref, rest, err := readRef(client, args, resolve, kinds...)
+	if err == nil && len(rest) > 0 {
+		err = fmt.Errorf("unexpected argument %q after %s (quote titles with spaces)", rest[0], ref)
+	}
+	return ref, err
+}
+
+// readRef is takeRef with resolve forcing a title search, for titles that
+// look like IDs (rate movie 1917 8 --resolve).
+func readRef(client *api.Client, args []string, resolve bool, kinds ...string) (api.MediaRef, []string, error) {
+	if len(args) == 0 {
+		return api.MediaRef{}, nil, fmt.Errorf("missing %s reference", strings.Join(kinds, " or "))
+	}
+	var ref api.MediaRef
+	var err error
+	rest := args[1:]
+	if kind := args[0]; (kind == "episode" || slices.Contains(api.RefKinds, kind)) && len(args) > 1 {
+		searchKind := kind
+		if kind == "episode" {
+			searchKind = "tv"
+		}
+		ref, err = parseRef(client, args[1], resolve, searchKind)
+		if err != nil {
+			return ref, nil, err
+		}
+		rest = args[2:]
+		if kind == "episode" {
+			if len(rest) == 0 {
+				return ref, nil, fmt.Errorf("missing episode code (use S01E02)")
+			}
+			ref.HasSeason = true
+			ref.Season, ref.Episode, err = parseEpisodeCode(rest[0])
+			if err != nil {
+				return ref, nil, err
+			}
+			rest = rest[1:]
+			kind = "tv"
+		}
+		if ref.Kind != "" && ref.Kind != kind {
+			return ref, nil, fmt.Errorf("%s is not a %s reference", args[1], kind)
+		}
+		ref.Kind = kind
+	} else if ref, err = parseRef(client, args[0], resolve, kinds...); err != nil {
+		return ref, nil, err
+	}
+
+	if ref.Kind == "" && len(kinds) == 1 {
+		ref.Kind = kinds[0]
+	}
+	if ref.IMDbID != "" {
+		if err := resolveIMDbRef(client, &ref); err != nil {
+			return ref, nil, err
+		}
+	}
+	if ref.Kind == "" {
+		return ref, nil, fmt.Errorf("ambiguous ID %d: say which, e.g. movie:%d or tv:%d", ref.ID, ref.ID, ref.ID)
+	}
+	if len(kinds) > 0 && !slices.Contains(kinds, ref.Kind) {
+		return ref, nil, fmt.Errorf("%s: expected a %s reference", ref, strings.Join(kinds, " or "))
+	}
+	if ref.Kind == "tv" && !ref.HasSeason && len(rest) > 0 {
+		if season, episode, err := parseSeasonOrEpisode(rest[0]); err == nil {
+			ref.HasSeason, ref.Season, ref.Episode = true, season, episode
+			rest = rest[1:]
+		}
+	}
+	return ref, rest, nil
+}
+
+// parseRef parses a single reference. Input that isn't one, such as
+// "The Matrix (1999)", is looked up as a title among the kinds that can be
+// searched; resolve does that even for input that parses, like "1917". On a
+// terminal, unclear titles are offered in a picker.
+func parseRef(client *api.Client, s string, resolve bool, kinds ...string) (api.MediaRef, error) {
+	ref, err := api.ParseMediaRef(s)
+	if err == nil && !resolve {
+		return ref, nil
+	}
+	var searchable []string
+	for _, k := range kinds {
+		if slices.Contains(api.TitleKinds, k) {
+			searchable = append(searchable, k)
+		}
+	}
+	if len(searchable) == 0 || (err != nil && !isTitleQuery(s)) {
+		return ref, err
+	}
+	ref, err = client.ResolveTitle(s, searchable...)
+	var amb *api.AmbiguousError
+	if errors.As(err, &amb) && output.Interactive() {
+		picked, err := output.PickCandidate(s, amb.Candidates)
+		if errors.Is(err, output.ErrNoChoice) {
+			return ref, fmt.Errorf("no match chosen for %q", s)
+		}
+		return picked.Ref(), err
+	}
+	return ref, err
+}
+
+// isTitleQuery reports whether input that failed to parse as a reference
+// should be searched as a title rather than reported as a malformed one:
+// typed references (tv:1396/X) and TMDB URLs are kept as errors.
+func isTitleQuery(s string) bool {
+	if strings.Contains(s, "themoviedb.org/") {
+		return false
+	}
+	kind, _, ok := strings.Cut(strings.TrimPrefix(s, "["), ":")
+	return !ok || !slices.Contains(api.RefKinds, kind)
+}
+
+// resolveIMDbRef replaces an IMDb ID with the TMDB entry it belongs to. An
+// IMDb episode ID becomes a reference to that episode of its show.
+func resolveIMDbRef(client *api.Client, ref *api.MediaRef) error {
+	resp, err := client.FindByExternalID("imdb", ref.IMDbID)
+	if err != nil {
+		return err
+	}
+	kind := ref.Kind
+	switch {
+	case (kind == "" || kind == "movie") && len(resp.MovieResults) > 0:
+		ref.Kind, ref.ID = "movie", resp.MovieResults[0].ID
+	case (kind == "" || kind == "tv") && len(resp.TVResults) > 0:
+		ref.Kind, ref.ID = "tv", resp.TVResults[0].ID
+	case (kind == "" || kind == "tv") && len(resp.TVEpisodeResults) > 0:
+		e := resp.TVEpisodeResults[0]
+		ref.Kind, ref.ID = "tv", e.ShowID
+		ref.HasSeason, ref.Season, ref.Episode = true, e.SeasonNumber, e.EpisodeNumber
+	case (kind == "" || kind == "person") && len(resp.PersonResults) > 0:
+		ref.Kind, ref.ID = "person", resp.PersonResults[0].ID
+	default:
+		if kind == "" {
+			kind = "entry"
+		}
+		return fmt.Errorf("no %s found for IMDb ID %s", kind, ref.IMDbID)
+	}
+	ref.IMDbID = ""
+	return nil
+}
+
+// refMaxAge is how long reference data (countries, languages) is cached.
+const refMaxAge = 30 * 24 * time.Hour
+
+// loadRef reads reference data from the cache, fetching it when stale.
+func loadRef[T any](name string, fetch func() (T, error)) (T, error) {
+	var v T
+	if config.ReadCache(name, refMaxAge, &v) {
+		return v, nil
+	}
+	v, err := fetch()
+	if err != nil {
+		return v, err
+	}
+	_ = config.WriteCache(name, v)
+	return v, nil
+}
+
+// checkRegion upper-cases an ISO 3166-1 region code and checks that TMDB
+// knows it, suggesting close matches when it doesn't. Values pass unchecked
+// when the country list can't be loaded.
+func checkRegion(client *api.Client, flag, region string) (string, error) {
+	if region == "" {
+		return "", nil
+	}
+	region = strings.ToUpper(region)
+	countries, err := loadRef("countries", client.Countries)
+	if err != nil {
+		return region, nil
+	}
+	names := make(map[string]string, len(countries))
+	for _, c := range countries {
+		names[c.Code] = c.EnglishName
+	}
+	if _, ok := names[region]; ok {
+		return region, nil
+	}
+	return "", unknownCode(flag, "region", region, names, "ref countries")
+}
+
+// checkLanguage checks an ISO 639-1 language code, optionally with a region
+// as in fi-FI, and normalises its case.
+func checkLanguage(client *api.Client, flag, lang string) (string, error) {
+	if lang == "" {
+		return "", nil
+	}
+	code, region, hasRegion := strings.Cut(lang, "-")
+	code = strings.ToLower(code)
+	languages, err := loadRef("languages", client.Languages)
+	if err != nil {
+		return lang, nil
+	}
+	names := make(map[string]string, len(languages))
+	for _, l := range languages {
+		names[l.Code] = l.EnglishName
+	}
+	if _, ok := names[code]; !ok {
+		return "", unknownCode(flag, "language", code, names, "ref languages")
+	}
+	if !hasRegion {
+		return code, nil
+	}
+	region, err = checkRegion(client, flag, region)
+	if err != nil {
+		return "", err
+	}
+	return code + "-" + region, nil
+}
+
+// checkLanguageCode checks a bare ISO 639-1 code, for flags that match a
+// language field and so take no region.
+func checkLanguageCode(client *api.Client, flag, lang string) (string, error) {
+	if strings.Contains(lang, "-") {
+		return "", fmt.Errorf("%s takes a language code without a region (like fi), not %q", flag, lang)
+	}
+	return checkLanguage(client, flag, lang)
+}
+
+// mustRegion, mustLanguage and mustLanguageCode exit on invalid codes.
+func mustRegion(client *api.Client, flag, region string) string {
+	region, err := checkRegion(client, flag, region)
+	exitOnErr(err)
+	return region
+}
+
+func mustLanguage(client *api.Client, flag, lang string) string {
+	lang, err := checkLanguage(client, flag, lang)
+	exitOnErr(err)
+	return lang
+}
+
+func mustLanguageCode(client *api.Client, flag, lang string) string {
+	lang, err := checkLanguageCode(client, flag, lang)
+	exitOnErr(err)
+	return lang
+}
+
+func unknownCode(flag, kind, value string, names map[string]string, refCmd string) error {
+	msg := fmt.Sprintf("unknown %s %q for %s", kind, value, flag)
+	if s := suggestCodes(value, names); len(s) > 0 {
+		msg += "; did you mean " + strings.Join(s, ", ") + "?"
+	}
+	return fmt.Errorf("%s (see: themoviedb-cli %s)", msg, refCmd)
+}
+
+// suggestCodes returns up to three codes close to input, as "FI (Finland)".
+// A name typed instead of a code ranks first, then codes one letter off.
+func suggestCodes(input string, names map[string]string) []string {
+	type match struct {
+		code  string
+		score int
+	}
+	in := strings.ToLower(input)
+	var matches []match
+	for code, name := range names {
+		c, n := strings.ToLower(code), strings.ToLower(name)
+		score := -1
+		switch {
+		case n == in:
+			score = 0
+		case len(in) >= 3 && strings.HasPrefix(n, in):
+			score = 1
+		case len(in) >= 4 && cli.Levenshtein(n, in) <= 2:
+			score = 2
+		case cli.Levenshtein(c, in) <= 1 && c != "" && in != "" && c[0] == in[0]:
+			score = 3
+		case cli.Levenshtein(c, in) <= 1:
+			score = 4
+		}
+		if score >= 0 {
+			matches = append(matches, match{code, score})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score < matches[j].score
+		}
+		return matches[i].code < matches[j].code
+	})
+	var out []string
+	for i, m := range matches {
+		if i == 3 {
+			break
+		}
+		out = append(out, fmt.Sprintf("%s (%s)", m.code, names[m.code]))
+	}
+	return out
+}
+
+func doRef(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
+	filter := strings.ToLower(strings.Join(args[1:], " "))
+	matches := func(values ...string) bool {
+		for _, v := range values {
+			if strings.Contains(strings.ToLower(v), filter) {
+				return true
+			}
+		}
+		return false
+	}
+	client := mustClient()
+
+	switch args[0] {
+	case "countries":
+		countries, err := loadRef("countries", client.Countries)
+		exitOnErr(err)
+		var filtered []api.Country
+		for _, c := range countries {
+			if matches(c.Code, c.EnglishName, c.NativeName) {
+				filtered = append(filtered, c)
+			}
+		}
+		sort.Slice(filtered, func(i, j int) bool { return filtered[i].Code < filtered[j].Code })
+		output.Countries(filtered, jsonFlag)
+	case "languages":
+		languages, err := loadRef("languages", client.Languages)
+		exitOnErr(err)
+		var filtered []api.Language
+		for _, l := range languages {
+			if matches(l.Code, l.EnglishName, l.Name) {
+				filtered = append(filtered, l)
+			}
+		}
+		sort.Slice(filtered, func(i, j int) bool { return filtered[i].Code < filtered[j].Code })
+		output.Languages(filtered, jsonFlag)
+	case "timezones":
+		zones, err := loadRef("timezones", client.Timezones)
+		exitOnErr(err)
+		var filtered []api.Timezones
+		for _, z := range zones {
+			if matches(append([]string{z.Country}, z.Zones...)...) {
+				filtered = append(filtered, z)
+			}
+		}
+		output.Timezones(filtered, jsonFlag)
+	case "jobs":
+		departments, err := loadRef("jobs", client.Jobs)
+		exitOnErr(err)
+		var filtered []api.DepartmentJobs
+		for _, d := range departments {
+			if matches(d.Department) {
+				sort.Strings(d.Jobs)
+				filtered = append(filtered, d)
+			}
+		}
+		sort.Slice(filtered, func(i, j int) bool { return filtered[i].Department < filtered[j].Department })
+		output.Jobs(filtered, jsonFlag)
+	case "translations":
+		codes, err := loadRef("primary_translations", client.PrimaryTranslations)
+		exitOnErr(err)
+		var filtered []string
+		for _, c := range codes {
+			if matches(c) {
+				filtered = append(filtered, c)
+			}
+		}
+		sort.Strings(filtered)
+		output.Codes(filtered, jsonFlag)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown reference data: %s (use countries, languages, timezones, jobs or translations)\n", args[0])
+		os.Exit(1)
+	}
+}
+
+func doFilmography(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	resolve := ctx.Bool("resolve")
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
+	client := mustClient()
+	ref, err := refOnly(client, args, resolve, "person")
+	exitOnErr(err)
+	resp, err := client.Filmography(ref.ID)
+	exitOnErr(err)
+	output.Filmography(resp.Cast, jsonFlag)
+}
+
+func doRate(ctx *cli.Context) {
+	args := ctx.Args
+	group := ctx.String("group")
+	resolve := ctx.Bool("resolve")
+	if len(args) < 2 {
+		usageExit(ctx)
+	}
+	client := mustClient()
+	ref, rest, err := readRef(client, args, resolve, "movie", "tv")
+	exitOnErr(err)
+	if len(rest) != 1 {
+		usageExit(ctx)
+	}
+	rating, err := strconv.ParseFloat(rest[0], 64)
+	exitOnErr(err)
+
+	switch {
+	case ref.Kind == "movie":
+		err = client.RateMovie(ref.ID, rating)
+		output.Status(fmt.Sprintf("rated movie %d as %.1f", ref.ID, rating), err)
+	case ref.Episode > 0:
+		season, episode, err := groupEpisode(client, ref, group)
+		exitOnErr(err)
+		err = client.RateEpisode(ref.ID, season, episode, rating)
+		output.Status(fmt.Sprintf("rated S%02dE%02d of %d as %.1f", season, episode, ref.ID, rating), err)
+	case ref.HasSeason:
+		fmt.Fprintln(os.Stderr, "Seasons can't be rated; rate the show or an episode (S01E02)")
+		os.Exit(1)
+	default:
+		err = client.RateTV(ref.ID, rating)
+		output.Status(fmt.Sprintf("rated TV %d as %.1f", ref.ID, rating), err)
+	}
+}
+
+func doUnrate(ctx *cli.Context) {
+	args := ctx.Args
+	group := ctx.String("group")
+	resolve := ctx.Bool("resolve")
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
+	client := mustClient()
+	ref, err := refOnly(client, args, resolve, "movie", "tv")
+	exitOnErr(err)
+
+	switch {
+	case ref.Kind == "movie":
+		err = client.DeleteMovieRating(ref.ID)
+		output.Status(fmt.Sprintf("removed rating from movie %d", ref.ID), err)
+	case ref.Episode > 0:
+		season, episode, err := groupEpisode(client, ref, group)
+		exitOnErr(err)
+		err = client.DeleteEpisodeRating(ref.ID, season, episode)
+		output.Status(fmt.Sprintf("removed rating from S%02dE%02d of %d", season, episode, ref.ID), err)
+	case ref.HasSeason:
+		fmt.Fprintln(os.Stderr, "Seasons have no rating; use the show or an episode (S01E02)")
+		os.Exit(1)
+	default:
+		err = client.DeleteTVRating(ref.ID)
+		output.Status(fmt.Sprintf("removed rating from TV %d", ref.ID), err)
+	}
+}
+
+func doWatchlist(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	availableOn := ctx.String("available-on")
+	resolve := ctx.Bool("resolve")
+	region := ctx.String("region")
+	if region == "" {
+		region = "US"
+	}
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
+	client := mustClient()
+	action := args[0]
+
+	switch action {
+	case "list":
+		mediaType := "movie"
+		if len(args) > 1 {
+			mediaType = args[1]
+		}
+		if availableOn != "" {
+			region = mustRegion(client, "--region", region)
+			doWatchlistAvailable(client, mediaType, splitList(availableOn), region, jsonFlag)
+			return
+		}
+		if mediaType == "tv" {
+			resp, err := client.GetWatchlistTV()
+			exitOnErr(err)
+			output.TVShows(resp.Results, jsonFlag)
+		} else {
+			resp, err := client.GetWatchlistMovies()
+			exitOnErr(err)
+			output.Movies(resp.Results, jsonFlag)
+		}
+
+	case "add":
+		if len(args) < 2 {
+			usageExit(ctx)
+		}
+		ref, err := refOnly(client, args[1:], resolve, "movie", "tv")
+		exitOnErr(err)
+		err = client.AddToWatchlist(ref.Kind, ref.ID)
+		output.Status(fmt.Sprintf("added %s to watchlist", ref), err)
+
+	case "remove":
+		if len(args) < 2 {
+			usageExit(ctx)
+		}
+		ref, err := refOnly(client, args[1:], resolve, "movie", "tv")
+		exitOnErr(err)
+		err = client.RemoveFromWatchlist(ref.Kind, ref.ID)
+		output.Status(fmt.Sprintf("removed %s from watchlist", ref), err)
+
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown watchlist action: %s\n", action)
+		os.Exit(1)
+	}
+}
+
+func doDiscover(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	filter := api.DiscoverFilter{
+		OriginalLanguage:     ctx.String("lang"),
+		Certification:        ctx.String("cert"),
+		CertificationCountry: ctx.String("cert-country"),
+		WatchRegion:          ctx.String("region"),
+		SortBy:               ctx.String("sort"),
+	}
+	genres := ctx.String("genre")
+	withoutGenres := ctx.String("without-genre")
+	keywords := ctx.String("keyword")
+	years := ctx.String("year")
+	minRating := ctx.String("min-rating")
+	maxRating := ctx.String("max-rating")
+	minVotes := ctx.String("min-votes")
+	minRuntime := ctx.String("min-runtime")
+	maxRuntime := ctx.String("max-runtime")
+	providers := ctx.String("provider")
+	companies := ctx.String("company")
+	people := ctx.String("person")
+	page := ctx.String("page")
+
+	mediaType := "movie"
+	if len(args) > 0 {
+		mediaType = args[0]
+	}
+	if (mediaType != "movie" && mediaType != "tv") || len(args) > 1 {
+		usageExit(ctx)
+	}
+	if filter.Certification != "" && filter.CertificationCountry == "" {
+		filter.CertificationCountry = "US"
+	}
+
+	var err error
+	if years != "" {
+		filter.YearFrom, filter.YearTo, err = parseYearRange(years)
+		exitOnErr(err)
+	}
+	filter.VoteAverageMin = parseFloatFlag("--min-rating", minRating)
+	filter.VoteAverageMax = parseFloatFlag("--max-rating", maxRating)
+	filter.VoteCountMin = parseIntFlag("--min-votes", minVotes)
+	filter.RuntimeMin = parseIntFlag("--min-runtime", minRuntime)
+	filter.RuntimeMax = parseIntFlag("--max-runtime", maxRuntime)
+	filter.Page = parseIntFlag("--page", page)
+	filter.WatchProviders, err = parseIDList(providers)
+	exitOnErr(err)
+	filter.Companies, err = parseIDList(companies)
+	exitOnErr(err)
+	filter.People, err = parseIDList(people)
+	exitOnErr(err)
+
+	client := mustClient()
+	filter.OriginalLanguage = mustLanguageCode(client, "--lang", filter.OriginalLanguage)
+	filter.CertificationCountry = mustRegion(client, "--cert-country", filter.CertificationCountry)
+	filter.WatchRegion = mustRegion(client, "--region", filter.WatchRegion)
+	if genres != "" || withoutGenres != "" {
+		list, err := client.Genres(mediaType)
+		exitOnErr(err)
+		filter.Genres, err = resolveGenres(list, genres)
+		exitOnErr(err)
+		filter.WithoutGenres, err = resolveGenres(list, withoutGenres)
+		exitOnErr(err)
+	}
+	filter.Keywords, err = resolveKeywords(client, keywords)
+	exitOnErr(err)
+
+	if mediaType == "tv" {
+		resp, err := client.DiscoverTV(filter)
+		exitOnErr(err)
+		output.TVShows(resp.Results, jsonFlag)
+	} else {
+		resp, err := client.DiscoverMovies(filter)
+		exitOnErr(err)
+		output.Movies(resp.Results, jsonFlag)
+	}
+}
+
+// parseYearRange parses "1999" or "1990-1999" into an inclusive range.
+func parseYearRange(s string) (int, int, error) {
+	from, to, isRange := strings.Cut(s, "-")
+	start, err := strconv.Atoi(strings.TrimSpace(from))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid year %q", s)
+	}
+	if !isRange {
+		return start, start, nil
+	}
+	end, err := strconv.Atoi(strings.TrimSpace(to))
+	if err != nil || end < start {
+		return 0, 0, fmt.Errorf("invalid year range %q", s)
+	}
+	return start, end, nil
+}
+
+// parseIDList parses a comma-separated list of numeric IDs.
+func parseIDList(s string) ([]int, error) {
+	var ids []int
+	for _, part := range splitList(s) {
+		id, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ID %q", part)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func splitList(s string) []string {
+	var parts []string
+	for _, p := range strings.Split(s, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return parts
+}
+
+// resolveGenres maps comma-separated genre names or IDs to genre IDs.
+func resolveGenres(genres []api.Genre, s string) ([]int, error) {
+	var ids []int
+	for _, part := range splitList(s) {
+		if id, err := strconv.Atoi(part); err == nil {
+			ids = append(ids, id)
+			continue
+		}
+		found := false
+		for _, g := range genres {
+			if strings.EqualFold(g.Name, part) {
+				ids = append(ids, g.ID)
+				found = true
+				break
+			}
+		}
+		if !found {
+			names := make([]string, len(genres))
+			for i, g := range genres {
+				names[i] = g.Name
+			}
+			return nil, fmt.Errorf("unknown genre %q (available: %s)", part, strings.Join(names, ", "))
+		}
+	}
+	return ids, nil
+}
+
+// resolveKeywords maps comma-separated keyword names or IDs to keyword IDs,
+// preferring an exact name match over the first search result.
+func resolveKeywords(client *api.Client, s string) ([]int, error) {
+	var ids []int
+	for _, part := range splitList(s) {
+		if id, err := strconv.Atoi(part); err == nil {
+			ids = append(ids, id)
+			continue
+		}
+		resp, err := client.SearchKeywords(part, api.SearchOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Results) == 0 {
+			return nil, fmt.Errorf("unknown keyword %q", part)
+		}
+		id := resp.Results[0].ID
+		for _, k := range resp.Results {
+			if strings.EqualFold(k.Name, part) {
+				id = k.ID
+				break
+			}
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func parseIntFlag(name, value string) int {
+	if value == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid value for %s: %s\n", name, value)
+		os.Exit(1)
+	}
+	return n
+}
+
+func parseFloatFlag(name, value string) float64 {
+	if value == "" {
+		return 0
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid value for %s: %s\n", name, value)
+		os.Exit(1)
+	}
+	return f
+}
+
+func doChart(ctx *cli.Context) {
+	cmd, args, jsonFlag := ctx.Name, ctx.Args, ctx.Bool("json")
+	region := ctx.String("region")
+	window := ctx.String("window")
+	page := parseIntFlag("--page", ctx.String("page"))
+	if window == "" {
+		window = "day"
+	}
+	if window != "day" && window != "week" {
+		fmt.Fprintf(os.Stderr, "Invalid window: %s (use day or week)\n", window)
+		os.Exit(1)
+	}
+
+	mediaType := "movie"
+	if len(args) > 0 {
+		mediaType = args[0]
+	}
+	switch cmd {
+	case "upcoming", "now-playing":
+		if mediaType != "movie" {
+			fmt.Fprintf(os.Stderr, "%s is only available for movies\n", cmd)
+			os.Exit(1)
+		}
+	case "airing-today", "on-the-air":
+		if len(args) > 0 && mediaType != "tv" {
+			fmt.Fprintf(os.Stderr, "%s is only available for tv\n", cmd)
+			os.Exit(1)
+		}
+		mediaType = "tv"
+	case "top":
+		if mediaType == "person" || mediaType == "all" {
+			fmt.Fprintln(os.Stderr, "top is only available for movie or tv")
+			os.Exit(1)
+		}
+	case "popular":
+		if mediaType == "all" {
+			fmt.Fprintln(os.Stderr, "popular is only available for movie, tv or person")
+			os.Exit(1)
+		}
+	}
+	if mediaType != "movie" && mediaType != "tv" && mediaType != "person" && mediaType != "all" {
+		fmt.Fprintf(os.Stderr, "Unknown media type: %s (use movie, tv, person, or all)\n", mediaType)
+		os.Exit(1)
+	}
+
+	if region != "" && mediaType != "movie" {
+		fmt.Fprintln(os.Stderr, "--region only applies to movie charts")
+		os.Exit(1)
+	}
+
+	client := mustClient()
+	region = mustRegion(client, "--region", region)
+	switch mediaType {
+	case "movie":
+		var resp *api.ReleaseWindowResponse
+		var err error
+		switch cmd {
+		case "trending":
+			resp, err = client.TrendingMovies(window, page)
+		case "popular":
+			resp, err = client.PopularMovies(page, region)
+		case "top":
+			resp, err = client.TopRatedMovies(page, region)
+		case "upcoming":
+			resp, err = client.UpcomingMovies(page, region)
+		case "now-playing":
+			resp, err = client.NowPlayingMovies(page, region)
+		}
+		exitOnErr(err)
+		output.ReleaseWindow(resp.Dates, region, jsonFlag)
+		output.Movies(resp.Results, jsonFlag)
+		output.Pagination(resp.Page, resp.TotalPages, jsonFlag)
+
+	case "tv":
+		var resp *api.SearchTVResponse
+		var err error
+		switch cmd {
+		case "trending":
+			resp, err = client.TrendingTV(window, page)
+		case "popular":
+			resp, err = client.PopularTV(page)
+		case "top":
+			resp, err = client.TopRatedTV(page)
+		case "airing-today":
+			resp, err = client.AiringTodayTV(page)
+		case "on-the-air":
+			resp, err = client.OnTheAirTV(page)
+		}
+		exitOnErr(err)
+		output.TVShows(resp.Results, jsonFlag)
+		output.Pagination(resp.Page, resp.TotalPages, jsonFlag)
+
+	case "all":
+		resp, err := client.TrendingAll(window, page)
+		exitOnErr(err)
+		output.Multi(resp.Results, jsonFlag)
+		output.Pagination(resp.Page, resp.TotalPages, jsonFlag)
+
+	case "person":
+		var resp *api.SearchPersonResponse
+		var err error
+		if cmd == "trending" {
+			resp, err = client.TrendingPeople(window, page)
+		} else {
+			resp, err = client.PopularPeople(page)
+		}
+		exitOnErr(err)
+		output.People(resp.Results, jsonFlag)
+		output.Pagination(resp.Page, resp.TotalPages, jsonFlag)
+	}
+}
+
+func doRecommend(ctx *cli.Context) {
+	cmd, args, jsonFlag := ctx.Name, ctx.Args, ctx.Bool("json")
+	hideRated := ctx.Bool("hide-rated")
+	hideWatchlist := ctx.Bool("hide-watchlist")
+	page := parseIntFlag("--page", ctx.String("page"))
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
+	client := mustClient()
+	ref, err := refOnly(client, args, false, "movie", "tv")
+	exitOnErr(err)
+	id := ref.ID
+
+	switch ref.Kind {
+	case "movie":
+		var resp *api.SearchMoviesResponse
+		if cmd == "similar" {
+			resp, err = client.SimilarMovies(id, page)
+		} else {
+			resp, err = client.MovieRecommendations(id, page)
+		}
+		exitOnErr(err)
+		seen := map[int]bool{}
+		if hideRated {
+			rated, err := client.GetAllRatedMovies()
+			exitOnErr(err)
+			for _, m := range rated {
+				seen[m.ID] = true
+			}
+		}
+		if hideWatchlist {
+			watchlist, err := client.GetAllWatchlistMovies()
+			exitOnErr(err)
+			for _, m := range watchlist {
+				seen[m.ID] = true
+			}
+		}
+		output.Movies(excludeMovies(resp.Results, seen), jsonFlag)
+		output.Pagination(resp.Page, resp.TotalPages, jsonFlag)
+
+	case "tv":
+		var resp *api.SearchTVResponse
+		if cmd == "similar" {
+			resp, err = client.SimilarTV(id, page)
+		} else {
+			resp, err = client.TVRecommendations(id, page)
+		}
+		exitOnErr(err)
+		seen := map[int]bool{}
+		if hideRated {
+			rated, err := client.GetAllRatedTV()
+			exitOnErr(err)
+			for _, s := range rated {
+				seen[s.ID] = true
+			}
+		}
+		if hideWatchlist {
+			watchlist, err := client.GetAllWatchlistTV()
+			exitOnErr(err)
+			for _, s := range watchlist {
+				seen[s.ID] = true
+			}
+		}
+		output.TVShows(excludeTV(resp.Results, seen), jsonFlag)
+		output.Pagination(resp.Page, resp.TotalPages, jsonFlag)
+	}
+}
+
+// excludeMovies drops movies whose IDs are in seen, preserving order.
+func excludeMovies(movies []api.MovieResult, seen map[int]bool) []api.MovieResult {
+	filtered := make([]api.MovieResult, 0, len(movies))
+	for _, m := range movies {
+		if !seen[m.ID] {
+			filtered = append(filtered, m)
+		}
+	}
+	return filtered
+}
+
+// excludeTV drops shows whose IDs are in seen, preserving order.
+func excludeTV(shows []api.TVResult, seen map[int]bool) []api.TVResult {
+	filtered := make([]api.TVResult, 0, len(shows))
+	for _, s := range shows {
+		if !seen[s.ID] {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered
+}
+
+func doBrowse(ctx *cli.Context) {
+	cmd, args, jsonFlag := ctx.Name, ctx.Args, ctx.Bool("json")
+	page := parseIntFlag("--page", ctx.String("page"))
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
+	client := mustClient()
+	id, err := resolveID(client, cmd, args[0])
+	exitOnErr(err)
+
+	switch cmd {
+	case "collection":
+		details, err := client.CollectionDetails(id)
+		exitOnErr(err)
+		output.Collection(details, jsonFlag)
+
+	case "company":
+		details, err := client.CompanyDetails(id)
+		exitOnErr(err)
+		movies, err := client.CompanyMovies(id, page)
+		exitOnErr(err)
+		output.Company(details, movies.Results, jsonFlag)
+		output.Pagination(movies.Page, movies.TotalPages, jsonFlag)
+
+	case "network":
+		details, err := client.NetworkDetails(id)
+		exitOnErr(err)
+		shows, err := client.NetworkTV(id, page)
+		exitOnErr(err)
+		output.Network(details, shows.Results, jsonFlag)
+		output.Pagination(shows.Page, shows.TotalPages, jsonFlag)
+
+	case "keyword":
+		keyword, err := client.KeywordDetails(id)
+		exitOnErr(err)
+		movies, err := client.KeywordMovies(id, page)
+		exitOnErr(err)
+		output.Keyword(keyword, movies.Results, jsonFlag)
+		output.Pagination(movies.Page, movies.TotalPages, jsonFlag)
+	}
+}
+
+// doWatchlistAvailable lists watchlist titles streamable in region on any of
+// the given providers (names or IDs).
+func doWatchlistAvailable(client *api.Client, mediaType string, providers []string, region string, jsonFlag bool) {
+	if mediaType == "tv" {
+		shows, err := client.GetAllWatchlistTV()
+		exitOnErr(err)
+		var available []api.TVResult
+		for _, s := range shows {
+			resp, err := client.TVWatchProviders(s.ID)
+			exitOnErr(err)
+			if matchesProvider(resp.Results[region].Streaming(), providers) {
+				available = append(available, s)
+			}
+		}
+		output.TVShows(available, jsonFlag)
+		return
+	}
+	movies, err := client.GetAllWatchlistMovies()
+	exitOnErr(err)
+	var available []api.MovieResult
+	for _, m := range movies {
+		resp, err := client.MovieWatchProviders(m.ID)
+		exitOnErr(err)
+		if matchesProvider(resp.Results[region].Streaming(), providers) {
+			available = append(available, m)
+		}
+	}
+	output.Movies(available, jsonFlag)
+}
+
+// matchesProvider reports whether any provider matches one of wanted, given as
+// provider IDs or case-insensitive name fragments ("netflix", "yle").
+func matchesProvider(providers []api.WatchProvider, wanted []string) bool {
+	for _, p := range providers {
+		name := strings.ToLower(p.ProviderName)
+		for _, w := range wanted {
+			if id, err := strconv.Atoi(w); err == nil {
+				if p.ProviderID == id {
+					return true
+				}
+			} else if strings.Contains(name, strings.ToLower(w)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func doProviders(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	region := strings.ToUpper(ctx.String("region"))
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
+	client := mustClient()
+	region = mustRegion(client, "--region", region)
+
+	switch args[0] {
+	case "regions":
+		regions, err := client.WatchRegions()
+		exitOnErr(err)
+		output.WatchRegions(regions, jsonFlag)
+
+	case "list":
+		mediaType := "movie"
+		if len(args) > 1 {
+			mediaType = args[1]
+		}
+		providers, err := client.ProviderCatalogue(mediaType, region)
+		exitOnErr(err)
+		output.ProviderList(providers, jsonFlag)
+
+	default:
+		if region == "" {
+			region = "US"
+		}
+		ref, err := refOnly(client, args, false, "movie", "tv")
+		exitOnErr(err)
+		var resp *api.WatchProvidersResponse
+		if ref.Kind == "tv" {
+			resp, err = client.TVWatchProviders(ref.ID)
+		} else {
+			resp, err = client.MovieWatchProviders(ref.ID)
+		}
+		exitOnErr(err)
+		output.Providers(resp.Results[region], region, jsonFlag)
+	}
+}
+
+func doVideos(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	trailer := ctx.Bool("trailer")
+	videoType := ctx.String("type")
+	lang := ctx.String("lang")
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
+	client := mustClient()
+	lang = mustLanguage(client, "--lang", lang)
+	ref, err := refOnly(client, args, false, "movie", "tv")
+	exitOnErr(err)
+
+	var videos []api.Video
+	switch {
+	case ref.Kind == "movie":
+		videos, err = client.MovieVideos(ref.ID, lang)
+	case ref.Episode > 0:
+		videos, err = client.EpisodeVideos(ref.ID, ref.Season, ref.Episode, lang)
+	case ref.HasSeason:
+		videos, err = client.SeasonVideos(ref.ID, ref.Season, lang)
+	default:
+		videos, err = client.TVVideos(ref.ID, lang)
+	}
+	exitOnErr(err)
+
+	if trailer {
+		best := api.BestTrailer(videos)
+		if best == nil {
+			fmt.Fprintln(os.Stderr, "No trailer found")
+			os.Exit(1)
+		}
+		output.Trailer(best, jsonFlag)
+		return
+	}
+	if videoType != "" {
+		var filtered []api.Video
+		for _, v := range videos {
+			if strings.EqualFold(v.Type, videoType) {
+				filtered = append(filtered, v)
+			}
+		}
+		videos = filtered
+	}
+	output.Videos(videos, jsonFlag)
+}
+
+// parseSeasonOrEpisode parses "S02" (episode 0) or "S02E03".
+func parseSeasonOrEpisode(code string) (int, int, error) {
+	upper := strings.ToUpper(code)
+	if strings.Contains(upper, "E") {
+		return parseEpisodeCode(code)
+	}
+	if !strings.HasPrefix(upper, "S") {
+		return 0, 0, fmt.Errorf("invalid season %q (use S01 or S01E02 format)", code)
+	}
+	season, err := strconv.Atoi(upper[1:])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid season in %q: %w", code, err)
+	}
+	return season, 0, nil
+}
+
+func doImages(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	kind := ctx.String("type")
+	lang := ctx.String("lang")
+	size := ctx.String("size")
+	minWidth := parseIntFlag("--min-width", ctx.String("min-width"))
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
+	if size == "" {
+		size = "original"
+	}
+	client := mustClient()
+	if lang != "none" {
+		lang = mustLanguageCode(client, "--lang", lang)
+	}
+	ref, err := refOnly(client, args, false, "movie", "tv", "person")
+	exitOnErr(err)
+
+	resp, err := targetImages(client, imageTargetOf(ref))
+	exitOnErr(err)
+	output.Images(filterImages(resp.All(), kind, lang, minWidth), size, jsonFlag)
+}
+
+// imageTarget is a title (or episode) to download artwork for.
+type imageTarget struct {
+	mediaType string // movie, tv or person
+	id        int
+	hasSeason bool // a season's posters, or an episode's stills with episode
+	season    int
+	episode   int // > 0 for episode stills
+}
+
+func imageTargetOf(ref api.MediaRef) imageTarget {
+	return imageTarget{mediaType: ref.Kind, id: ref.ID, hasSeason: ref.HasSeason, season: ref.Season, episode: ref.Episode}
+}
+
+// targetImages fetches the images of a title, season, episode or person.
+func targetImages(client *api.Client, t imageTarget) (*api.ImagesResponse, error) {
+	switch {
+	case t.mediaType == "person":
+		return client.PersonImages(t.id)
+	case t.mediaType != "tv":
+		return client.MovieImages(t.id)
+	case t.episode > 0:
+		return client.EpisodeImages(t.id, t.season, t.episode)
+	case t.hasSeason:
+		return client.SeasonImages(t.id, t.season)
+	}
+	return client.TVImages(t.id)
+}
+
+func doImagesDownload(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	kind := ctx.String("type")
+	size := ctx.String("size")
+	lang := ctx.String("lang")
+	dir := ctx.String("dir")
+	from := ctx.String("from")
+	if size == "" {
+		size = "original"
+	}
+	if dir == "" {
+		dir = "."
+	}
+	if (from == "" && len(args) == 0) || (from != "" && len(args) > 1) {
+		usageExit(ctx)
+	}
+	client := mustClient()
+	if lang != "none" {
+		lang = mustLanguageCode(client, "--lang", lang)
+	}
+
+	var targets []imageTarget
+	if from != "" {
+		mediaType := "movie"
+		if len(args) > 0 {
+			mediaType = args[0]
+		}
+		ids, err := listIDs(client, from, mediaType)
+		exitOnErr(err)
+		for _, id := range ids {
+			targets = append(targets, imageTarget{mediaType: mediaType, id: id})
+		}
+	} else {
+		ref, err := refOnly(client, args, false, "movie", "tv", "person")
+		exitOnErr(err)
+		targets = append(targets, imageTargetOf(ref))
+	}
+	exitOnErr(os.MkdirAll(dir, 0755))
+
+	sources := readImageSources(dir)
+	var results []output.DownloadResult
+	failed := false
+	for _, t := range targets {
+		k := kind
+		if k == "" {
+			k = defaultImageKind(t)
+		}
+		result, err := downloadImage(client, t, k, lang, size, dir, sources)
+		if err != nil {
+			result.Status = "error: " + err.Error()
+			failed = true
+		}
+		results = append(results, result)
+	}
+	if err := writeImageSources(dir, sources); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+	output.Downloads(results, jsonFlag)
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// listIDs returns the IDs of a user list: watchlist, rated or favorite.
+func listIDs(client *api.Client, list, mediaType string) ([]int, error) {
+	entries, err := listEntries(client, list, mediaType)
+	ids := make([]int, len(entries))
+	for i, e := range entries {
+		ids[i] = e.id
+	}
+	return ids, err
+}
+
+// listEntry is a title on one of the account's lists.
+type listEntry struct {
+	id    int
+	title string
+}
+
+// listEntries returns the titles on the account's watchlist, rated or
+// favorite list.
+func listEntries(client *api.Client, list, mediaType string) ([]listEntry, error) {
+	if mediaType != "movie" && mediaType != "tv" {
+		return nil, fmt.Errorf("lists hold movies or tv shows, not %q", mediaType)
+	}
+	var entries []listEntry
+	switch {
+	case list == "watchlist" && mediaType == "tv":
+		shows, err := client.GetAllWatchlistTV()
+		for _, s := range shows {
+			entries = append(entries, listEntry{s.ID, s.Name})
+		}
+		return entries, err
+	case list == "watchlist":
+		movies, err := client.GetAllWatchlistMovies()
+		for _, m := range movies {
+			entries = append(entries, listEntry{m.ID, m.Title})
+		}
+		return entries, err
+	case list == "rated" && mediaType == "tv":
+		shows, err := client.GetAllRatedTV()
+		for _, s := range shows {
+			entries = append(entries, listEntry{s.ID, s.Name})
+		}
+		return entries, err
+	case list == "rated":
+		movies, err := client.GetAllRatedMovies()
+		for _, m := range movies {
+			entries = append(entries, listEntry{m.ID, m.Title})
+		}
+		return entries, err
+	case list == "favorite" && mediaType == "tv":
+		shows, err := client.GetFavoriteTV()
+		for _, s := range shows {
+			entries = append(entries, listEntry{s.ID, s.Name})
+		}
+		return entries, err
+	case list == "favorite":
+		movies, err := client.GetFavoriteMovies()
+		for _, m := range movies {
+			entries = append(entries, listEntry{m.ID, m.Title})
+		}
+		return entries, err
+	}
+	return nil, fmt.Errorf("unknown list %q (use watchlist, rated, or favorite)", list)
+}
+
+func defaultImageKind(t imageTarget) string {
+	switch {
+	case t.episode > 0:
+		return "still"
+	case t.mediaType == "person":
+		return "profile"
+	}
+	return "poster"
+}
+
+// downloadImage saves the top-voted image of kind for t into dir, preferring
+// lang when given. A file that sources records as already fetched from the
+// same URL, with its checksum intact, is not downloaded again; sources is
+// updated with what was saved.
+func downloadImage(client *api.Client, t imageTarget, kind, lang, size, dir string, sources map[string]imageSource) (output.DownloadResult, error) {
+	var result output.DownloadResult
+	resp, err := targetImages(client, t)
+	if err != nil {
+		return result, err
+	}
+	candidates := filterImages(resp.All(), kind, lang, 0)
+	if len(candidates) == 0 {
+		candidates = filterImages(resp.All(), kind, "", 0)
+	}
+	size = output.ImageSize(kind, size)
+	result.File = filepath.Join(dir, imageFileName(t, kind, size, ""))
+	if len(candidates) == 0 {
+		return result, fmt.Errorf("no %s image", kind)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].VoteAverage > candidates[j].VoteAverage
+	})
+	img := candidates[0]
+	name := imageFileName(t, kind, size, img.FilePath)
+	result.File = filepath.Join(dir, name)
+	result.URL = output.ImageURL(kind, img.FilePath, size)
+	if src, ok := sources[name]; ok && src.URL == result.URL && fileChecksum(result.File) == src.SHA256 {
+		result.Status = "unchanged"
+		return result, nil
+	}
+	data, err := client.DownloadImage(result.URL)
+	if err != nil {
+		return result, err
+	}
+	result.Status, err = writeIfChanged(result.File, data)
+	if err == nil {
+		sources[name] = imageSource{URL: result.URL, SHA256: fmt.Sprintf("%x", sha256.Sum256(data))}
+	}
+	return result, err
+}
+
+// imageSourcesFile records, in a download directory, which URL each image
+// file came from and its checksum.
+const imageSourcesFile = ".themoviedb-images.json"
 
-	// Create session
-	sessionID, err := client.CreateSession(reqToken)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error creating session: %v\n", err)
-		os.Exit(1)
+type imageSource struct {
+	URL    string `json:"url"`
+	SHA256 string `json:"sha256"`
+}
+
+// readImageSources returns the download records in dir, or an empty map.
+func readImageSources(dir string) map[string]imageSource {
+	sources := map[string]imageSource{}
+	if data, err := os.ReadFile(filepath.Join(dir, imageSourcesFile)); err == nil {
+		_ = json.Unmarshal(data, &sources)
 	}
+	return sources
+}
 
-	// Get account info
-	sessionClient := api.New(token, sessionID, 0, "")
-	account, err := sessionClient.GetAccount()
+func writeImageSources(dir string, sources map[string]imageSource) error {
+	data, err := json.MarshalIndent(sources, "", "  ")
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error getting account: %v\n", err)
-		os.Exit(1)
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, imageSourcesFile), data, 0644); err != nil {
+		return fmt.Errorf("recording image sources: %w", err)
 	}
+	return nil
+}
 
-	accountObjectID := extractJWTSub(token)
+// fileChecksum returns the hex SHA-256 of a file, or "" if it can't be read.
+func fileChecksum(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
 
-	cfg := &config.Config{
-		AccessToken:     token,
-		SessionID:       sessionID,
-		AccountID:       account.ID,
-		AccountObjectID: accountObjectID,
+// imageFileName builds a deterministic name such as "movie-603-poster-w500.jpg"
+// or "tv-1396-S05E16-still-original.jpg".
+func imageFileName(t imageTarget, kind, size, filePath string) string {
+	name := fmt.Sprintf("%s-%d", t.mediaType, t.id)
+	switch {
+	case t.episode > 0:
+		name += fmt.Sprintf("-S%02dE%02d", t.season, t.episode)
+	case t.hasSeason:
+		name += fmt.Sprintf("-S%02d", t.season)
 	}
-	if err := config.Save(cfg); err != nil {
-		fmt.Fprintf(os.Stderr, "Error saving config: %v\n", err)
-		os.Exit(1)
+	ext := filepath.Ext(filePath)
+	if ext == "" {
+		ext = ".jpg"
 	}
+	return fmt.Sprintf("%s-%s-%s%s", name, kind, size, ext)
+}
 
-	fmt.Printf("Logged in as %s (account %d)\n", account.Username, account.ID)
+// writeIfChanged writes data to path unless the file already has the same
+// SHA-256 checksum. It returns "saved", "updated" or "unchanged".
+func writeIfChanged(path string, data []byte) (string, error) {
+	status := "saved"
+	if existing, err := os.ReadFile(path); err == nil {
+		if sha256.Sum256(existing) == sha256.Sum256(data) {
+			return "unchanged", nil
+		}
+		status = "updated"
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", err
+	}
+	return status, nil
 }
 
-func doLogout() {
-	path := config.Path()
-	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
-		fmt.Fprintf(os.Stderr, "Error removing config: %v\n", err)
-		os.Exit(1)
+// filterImages keeps images of kind (any if empty), in language lang ("none"
+// for textless images, any if empty) and at least minWidth pixels wide.
+func filterImages(images []api.TypedImage, kind, lang string, minWidth int) []api.TypedImage {
+	var filtered []api.TypedImage
+	for _, img := range images {
+		if kind != "" && img.Kind != kind {
+			continue
+		}
+		if (lang == "none" && img.Language != "") || (lang != "" && lang != "none" && img.Language != lang) {
+			continue
+		}
+		if img.Width < minWidth {
+			continue
+		}
+		filtered = append(filtered, img)
 	}
-	fmt.Println("Logged out. Credentials removed.")
+	return filtered
 }
 
-func doSearch(args []string, jsonFlag bool) {
+func doCredits(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	showCast := ctx.Bool("cast")
+	showCrew := ctx.Bool("crew")
+	department := ctx.String("department")
 	if len(args) == 0 {
-		fmt.Fprintln(os.Stderr, "Usage: themoviedb-cli search <query>")
-		os.Exit(1)
+		usageExit(ctx)
+	}
+	if department != "" && !showCast {
+		showCrew = true
+	}
+	if !showCast && !showCrew {
+		showCast, showCrew = true, true
 	}
-	query := strings.Join(args, " ")
 	client := mustClient()
+	ref, err := refOnly(client, args, false, "movie", "tv")
+	exitOnErr(err)
+	if ref.Episode > 0 {
+		fmt.Fprintln(os.Stderr, "Credits are available per series or season (use S01)")
+		os.Exit(1)
+	}
 
-	// Check for type prefix
 	switch {
-	case strings.HasPrefix(query, "tv:"):
-		q := strings.TrimPrefix(query, "tv:")
-		resp, err := client.SearchTV(strings.TrimSpace(q))
-		exitOnErr(err)
-		output.TVShows(resp.Results, jsonFlag)
-
-	case strings.HasPrefix(query, "person:"):
-		q := strings.TrimPrefix(query, "person:")
-		resp, err := client.SearchPerson(strings.TrimSpace(q))
-		exitOnErr(err)
-		output.People(resp.Results, jsonFlag)
-
-	case strings.HasPrefix(query, "movie:"):
-		q := strings.TrimPrefix(query, "movie:")
-		resp, err := client.SearchMovies(strings.TrimSpace(q))
+	case ref.Kind == "movie" || ref.HasSeason:
+		var credits *api.MovieCredits
+		if ref.Kind == "movie" {
+			credits, err = client.MovieCredits(ref.ID)
+		} else {
+			credits, err = client.SeasonCredits(ref.ID, ref.Season)
+		}
 		exitOnErr(err)
-		output.Movies(resp.Results, jsonFlag)
-
+		if !showCast {
+			credits.Cast = nil
+		}
+		if !showCrew {
+			credits.Crew = nil
+		}
+		credits.Crew = filterCrew(credits.Crew, department, func(c api.CrewMember) string { return c.Department })
+		output.Credits(credits, jsonFlag)
 	default:
-		// Default: search movies
-		resp, err := client.SearchMovies(query)
+		credits, err := client.AggregateCredits(ref.ID)
 		exitOnErr(err)
-		output.Movies(resp.Results, jsonFlag)
+		if !showCast {
+			credits.Cast = nil
+		}
+		if !showCrew {
+			credits.Crew = nil
+		}
+		credits.Crew = filterCrew(credits.Crew, department, func(c api.AggregateCrewMember) string { return c.Department })
+		output.AggregateCredits(credits, jsonFlag)
+	}
+}
+
+// filterCrew keeps crew members of department (case-insensitive); an empty
+// department keeps everyone. departmentOf reads a member's department.
+func filterCrew[T any](crew []T, department string, departmentOf func(T) string) []T {
+	if department == "" {
+		return crew
+	}
+	var filtered []T
+	for _, c := range crew {
+		if strings.EqualFold(departmentOf(c), department) {
+			filtered = append(filtered, c)
+		}
 	}
+	return filtered
 }
 
-func doFilmography(args []string, jsonFlag bool) {
+func doReviews(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	full := ctx.Bool("full")
+	page := parseIntFlag("--page", ctx.String("page"))
 	if len(args) == 0 {
-		fmt.Fprintln(os.Stderr, "Usage: themoviedb-cli filmography <person_id>")
-		os.Exit(1)
+		usageExit(ctx)
 	}
-	id, err := strconv.Atoi(args[0])
-	exitOnErr(err)
 	client := mustClient()
-	resp, err := client.Filmography(id)
+
+	if len(args) == 1 && isReviewID(args[0]) {
+		review, err := client.Review(api.TrimRef(args[0], "review"))
+		exitOnErr(err)
+		output.Reviews([]api.Review{*review}, true, jsonFlag)
+		return
+	}
+
+	ref, err := refOnly(client, args, false, "movie", "tv")
 	exitOnErr(err)
-	output.Filmography(resp.Cast, jsonFlag)
+	var resp *api.ReviewsResponse
+	if ref.Kind == "tv" {
+		resp, err = client.TVReviews(ref.ID, page)
+	} else {
+		resp, err = client.MovieReviews(ref.ID, page)
+	}
+	exitOnErr(err)
+	if len(resp.Results) == 0 && !jsonFlag {
+		fmt.Println("No reviews")
+		return
+	}
+	output.Reviews(resp.Results, full, jsonFlag)
+	output.Pagination(resp.Page, resp.TotalPages, jsonFlag)
+}
+
+// reviewIDRe matches TMDB review IDs, which are 24-digit hex strings.
+var reviewIDRe = regexp.MustCompile(`^[0-9a-f]{24}$`)
+
+// isReviewID reports whether a reviews argument names a single review
+// (review:<id> or a bare review ID) rather than a movie or show.
+func isReviewID(arg string) bool {
+	typed := strings.HasPrefix(strings.TrimPrefix(strings.TrimSpace(arg), "["), "review:")
+	return typed || reviewIDRe.MatchString(api.TrimRef(arg, "review"))
 }
 
-func doRate(args []string) {
-	if len(args) < 3 {
-		fmt.Fprintln(os.Stderr, "Usage: themoviedb-cli rate <movie|tv|episode> <id> <rating>")
-		fmt.Fprintln(os.Stderr, "  For episodes: themoviedb-cli rate episode <series_id> S01E02 <rating>")
+func doList(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	comment := ctx.String("comment")
+	description := ctx.String("description")
+	name := ctx.String("name")
+	lang := ctx.String("lang")
+	sortBy := ctx.String("sort")
+	public := ctx.Bool("public")
+	private := ctx.Bool("private")
+	page := parseIntFlag("--page", ctx.String("page"))
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
+	action := args[0]
+	if action == "mine" {
+		client := mustClient()
+		lists, err := client.MyLists(page)
+		exitOnErr(err)
+		output.Lists(lists.Results, jsonFlag)
+		output.Pagination(lists.Page, lists.TotalPages, jsonFlag)
+		return
+	}
+	if action == "create" {
+		if len(args) < 2 {
+			usageExit(ctx)
+		}
+		client := mustListEditor()
+		lang = mustLanguageCode(client, "--lang", lang)
+		id, err := client.CreateList(api.ListOptions{
+			Name:        strings.Join(args[1:], " "),
+			Description: description,
+			Language:    lang,
+			Public:      &public,
+		})
+		output.Status(fmt.Sprintf("created list %d", id), err)
+		return
+	}
+
+	if len(args) < 2 {
+		usageExit(ctx)
+	}
+	listID, err := strconv.Atoi(api.TrimRef(args[1], "list"))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid list ID: %s\n", args[1])
 		os.Exit(1)
 	}
-	client := mustClient()
-	mediaType := args[0]
 
-	switch mediaType {
-	case "movie":
-		id, err := strconv.Atoi(args[1])
-		exitOnErr(err)
-		rating, err := strconv.ParseFloat(args[2], 64)
+	switch action {
+	case "show":
+		client := mustClient()
+		list, err := client.GetList(listID, page)
 		exitOnErr(err)
-		err = client.RateMovie(id, rating)
-		output.Status(fmt.Sprintf("rated movie %d as %.1f", id, rating), err)
+		output.List(list, jsonFlag)
+		output.Pagination(list.Page, list.TotalPages, jsonFlag)
 
-	case "tv":
-		id, err := strconv.Atoi(args[1])
-		exitOnErr(err)
-		rating, err := strconv.ParseFloat(args[2], 64)
+	case "add", "remove", "comment":
+		if len(args) < 3 {
+			usageExit(ctx)
+		}
+		if action == "comment" && !ctx.Bool("comment") {
+			fmt.Fprintln(os.Stderr, "list comment needs --comment (use --comment \"\" to clear)")
+			os.Exit(1)
+		}
+		client := mustListEditor()
+		items, err := parseListItems(client, args[2:], comment)
 		exitOnErr(err)
-		err = client.RateTV(id, rating)
-		output.Status(fmt.Sprintf("rated TV %d as %.1f", id, rating), err)
+		switch action {
+		case "add":
+			err = client.AddListItems(listID, items)
+			output.Status(fmt.Sprintf("added %d item(s) to list %d", len(items), listID), err)
+		case "remove":
+			err = client.RemoveListItems(listID, items)
+			output.Status(fmt.Sprintf("removed %d item(s) from list %d", len(items), listID), err)
+		case "comment":
+			err = client.UpdateListItems(listID, items)
+			output.Status(fmt.Sprintf("updated comments on list %d", listID), err)
+		}
 
-	case "episode":
-		if len(args) < 4 {
-			fmt.Fprintln(os.Stderr, "Usage: themoviedb-cli rate episode <series_id> S01E02 <rating>")
+	case "update":
+		if public && private {
+			fmt.Fprintln(os.Stderr, "--public and --private can't be used together")
 			os.Exit(1)
 		}
-		seriesID, err := strconv.Atoi(args[1])
-		exitOnErr(err)
-		season, episode, err := parseEpisodeCode(args[2])
-		exitOnErr(err)
-		rating, err := strconv.ParseFloat(args[3], 64)
-		exitOnErr(err)
-		err = client.RateEpisode(seriesID, season, episode, rating)
-		output.Status(fmt.Sprintf("rated S%02dE%02d of %d as %.1f", season, episode, seriesID, rating), err)
+		client := mustListEditor()
+		opts := api.ListOptions{Name: name, Description: description, SortBy: sortBy}
+		opts.Language = mustLanguageCode(client, "--lang", lang)
+		if public || private {
+			opts.Public = &public
+		}
+		err := client.UpdateList(listID, opts)
+		output.Status(fmt.Sprintf("updated list %d", listID), err)
+
+	case "clear":
+		client := mustListEditor()
+		err := client.ClearList(listID)
+		output.Status(fmt.Sprintf("cleared list %d", listID), err)
+
+	case "delete":
+		client := mustListEditor()
+		err := client.DeleteList(listID)
+		output.Status(fmt.Sprintf("deleted list %d", listID), err)
 
 	default:
-		fmt.Fprintf(os.Stderr, "Unknown media type: %s (use movie, tv, or episode)\n", mediaType)
+		fmt.Fprintf(os.Stderr, "Unknown list action: %s\n", action)
 		os.Exit(1)
 	}
 }
 
-func doUnrate(args []string) {
-	if len(args) < 2 {
-		fmt.Fprintln(os.Stderr, "Usage: themoviedb-cli unrate <movie|tv|episode> <id> [S01E02]")
+// mustListEditor returns a client that can edit lists, which needs the V4
+// user access token from "login --lists".
+func mustListEditor() *api.Client {
+	cfg, err := config.Load()
+	exitOnErr(err)
+	if cfg.AccessToken != "" && cfg.UserAccessToken == "" {
+		fmt.Fprintln(os.Stderr, "Editing lists needs write access. Run: themoviedb-cli login --lists")
 		os.Exit(1)
 	}
-	client := mustClient()
-	mediaType := args[0]
+	return mustClient()
+}
 
-	switch mediaType {
-	case "movie":
-		id, err := strconv.Atoi(args[1])
-		exitOnErr(err)
-		err = client.DeleteMovieRating(id)
-		output.Status(fmt.Sprintf("removed rating from movie %d", id), err)
+// parseListItems reads movie and TV references ("movie 603", "tv:1396",
+// URLs...) into list items, all with the same comment.
+func parseListItems(client *api.Client, args []string, comment string) ([]api.ListItem, error) {
+	var items []api.ListItem
+	for len(args) > 0 {
+		ref, rest, err := takeRef(client, args, "movie", "tv")
+		if err != nil {
+			return nil, err
+		}
+		if ref.HasSeason {
+			return nil, fmt.Errorf("%s: lists hold movies and shows, not seasons or episodes", ref)
+		}
+		items = append(items, api.ListItem{MediaType: ref.Kind, MediaID: ref.ID, Comment: comment})
+		args = rest
+	}
+	return items, nil
+}
 
-	case "tv":
-		id, err := strconv.Atoi(args[1])
-		exitOnErr(err)
-		err = client.DeleteTVRating(id)
-		output.Status(fmt.Sprintf("removed rating from TV %d", id), err)
+// changeWindowDays is the longest date window TMDB's change endpoints accept.
+const changeWindowDays = 14
 
-	case "episode":
-		if len(args) < 3 {
-			fmt.Fprintln(os.Stderr, "Usage: themoviedb-cli unrate episode <series_id> S01E02")
-			os.Exit(1)
+func doChanges(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	from := ctx.String("from")
+	to := ctx.String("to")
+	days := parseIntFlag("--days", ctx.String("days"))
+	lists := splitList(ctx.String("list"))
+	keys := splitList(ctx.String("keys"))
+	page := parseIntFlag("--page", ctx.String("page"))
+
+	start, end, err := parseChangeWindow(from, to, days, time.Now())
+	exitOnErr(err)
+
+	if len(args) > 0 && args[0] == "ids" {
+		if len(args) < 2 || (args[1] != "movie" && args[1] != "tv" && args[1] != "person") {
+			usageExit(ctx)
 		}
-		seriesID, err := strconv.Atoi(args[1])
-		exitOnErr(err)
-		season, episode, err := parseEpisodeCode(args[2])
+		if end.Sub(start) > changeWindowDays*24*time.Hour {
+			exitOnErr(fmt.Errorf("changes ids covers at most %d days", changeWindowDays))
+		}
+		client := mustClient()
+		resp, err := client.ChangedIDs(args[1], start.Format(time.DateOnly), end.Format(time.DateOnly), page)
 		exitOnErr(err)
-		err = client.DeleteEpisodeRating(seriesID, season, episode)
-		output.Status(fmt.Sprintf("removed rating from S%02dE%02d of %d", season, episode, seriesID), err)
+		output.ChangedIDs(args[1], resp.Results, jsonFlag)
+		output.Pagination(resp.Page, resp.TotalPages, jsonFlag)
+		return
+	}
 
-	default:
-		fmt.Fprintf(os.Stderr, "Unknown media type: %s (use movie, tv, or episode)\n", mediaType)
+	mediaTypes := []string{"movie", "tv"}
+	if len(args) > 0 {
+		if args[0] != "movie" && args[0] != "tv" {
+			usageExit(ctx)
+		}
+		mediaTypes = args[:1]
+	}
+	if len(lists) == 0 {
+		lists = []string{"watchlist", "rated"}
+	}
+
+	client := mustClient()
+	var results []output.ItemChanges
+	failed := false
+	for _, mediaType := range mediaTypes {
+		seen := map[int]bool{}
+		for _, list := range lists {
+			entries, err := listEntries(client, list, mediaType)
+			exitOnErr(err)
+			for _, e := range entries {
+				if seen[e.id] {
+					continue
+				}
+				seen[e.id] = true
+				item := output.ItemChanges{MediaType: mediaType, ID: e.id, Title: e.title}
+				changes, err := itemChanges(client, mediaType, e.id, start, end)
+				if err != nil {
+					item.Error = err.Error()
+					failed = true
+				}
+				item.Changes = filterChangeKeys(changes, keys)
+				if len(item.Changes) > 0 || item.Error != "" {
+					results = append(results, item)
+				}
+			}
+		}
+	}
+	if len(results) == 0 && !jsonFlag {
+		fmt.Printf("No changes between %s and %s\n", start.Format(time.DateOnly), end.Format(time.DateOnly))
+		return
+	}
+	output.Changes(results, jsonFlag)
+	if failed {
 		os.Exit(1)
 	}
 }
 
-func doWatchlist(args []string, jsonFlag bool) {
+// parseChangeWindow returns the window to check: --from/--to dates, or the
+// last days (default 7) up to now.
+func parseChangeWindow(from, to string, days int, now time.Time) (time.Time, time.Time, error) {
+	end := now
+	if to != "" {
+		t, err := time.Parse(time.DateOnly, to)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --to date %q (use YYYY-MM-DD)", to)
+		}
+		end = t
+	}
+	if days <= 0 {
+		days = 7
+	}
+	start := end.AddDate(0, 0, -days)
+	if from != "" {
+		t, err := time.Parse(time.DateOnly, from)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --from date %q (use YYYY-MM-DD)", from)
+		}
+		start = t
+	}
+	if start.After(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("--from %s is after --to %s", start.Format(time.DateOnly), end.Format(time.DateOnly))
+	}
+	return start, end, nil
+}
+
+// changeWindows splits a date range into the 14-day windows TMDB accepts.
+// Both ends of a window are included, so each starts the day after the last.
+func changeWindows(start, end time.Time) [][2]string {
+	var windows [][2]string
+	for {
+		last := start.AddDate(0, 0, changeWindowDays)
+		if !last.Before(end) {
+			return append(windows, [2]string{start.Format(time.DateOnly), end.Format(time.DateOnly)})
+		}
+		windows = append(windows, [2]string{start.Format(time.DateOnly), last.Format(time.DateOnly)})
+		start = last.AddDate(0, 0, 1)
+	}
+}
+
+func itemChanges(client *api.Client, mediaType string, id int, start, end time.Time) ([]api.Change, error) {
+	var all []api.Change
+	for _, w := range changeWindows(start, end) {
+		var changes []api.Change
+		var err error
+		if mediaType == "tv" {
+			changes, err = client.TVChanges(id, w[0], w[1])
+		} else {
+			changes, err = client.MovieChanges(id, w[0], w[1])
+		}
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, changes...)
+	}
+	return all, nil
+}
+
+// filterChangeKeys keeps changes to the given fields; no keys keeps all.
+func filterChangeKeys(changes []api.Change, keys []string) []api.Change {
+	if len(keys) == 0 {
+		return changes
+	}
+	var filtered []api.Change
+	for _, c := range changes {
+		for _, k := range keys {
+			if strings.EqualFold(c.Key, k) {
+				filtered = append(filtered, c)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+func doFavorite(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
 	if len(args) == 0 {
-		fmt.Fprintln(os.Stderr, "Usage: themoviedb-cli watchlist <add|remove|list> [movie|tv] [id]")
-		os.Exit(1)
+		usageExit(ctx)
 	}
 	client := mustClient()
 	action := args[0]
@@ -326,70 +2546,153 @@ func doWatchlist(args []string, jsonFlag bool) {
 			mediaType = args[1]
 		}
 		if mediaType == "tv" {
-			resp, err := client.GetWatchlistTV()
+			shows, err := client.GetFavoriteTV()
 			exitOnErr(err)
-			output.TVShows(resp.Results, jsonFlag)
+			output.TVShows(shows, jsonFlag)
 		} else {
-			resp, err := client.GetWatchlistMovies()
+			movies, err := client.GetFavoriteMovies()
 			exitOnErr(err)
-			output.Movies(resp.Results, jsonFlag)
+			output.Movies(movies, jsonFlag)
 		}
 
 	case "add":
-		if len(args) < 3 {
-			fmt.Fprintln(os.Stderr, "Usage: themoviedb-cli watchlist add <movie|tv> <id>")
-			os.Exit(1)
+		if len(args) < 2 {
+			usageExit(ctx)
 		}
-		id, err := strconv.Atoi(args[2])
+		ref, err := refOnly(client, args[1:], false, "movie", "tv")
 		exitOnErr(err)
-		err = client.AddToWatchlist(args[1], id)
-		output.Status(fmt.Sprintf("added %s %d to watchlist", args[1], id), err)
+		err = client.AddFavorite(ref.Kind, ref.ID)
+		output.Status(fmt.Sprintf("added %s to favorites", ref), err)
 
 	case "remove":
-		if len(args) < 3 {
-			fmt.Fprintln(os.Stderr, "Usage: themoviedb-cli watchlist remove <movie|tv> <id>")
-			os.Exit(1)
+		if len(args) < 2 {
+			usageExit(ctx)
 		}
-		id, err := strconv.Atoi(args[2])
+		ref, err := refOnly(client, args[1:], false, "movie", "tv")
 		exitOnErr(err)
-		err = client.RemoveFromWatchlist(args[1], id)
-		output.Status(fmt.Sprintf("removed %s %d from watchlist", args[1], id), err)
+		err = client.RemoveFavorite(ref.Kind, ref.ID)
+		output.Status(fmt.Sprintf("removed %s from favorites", ref), err)
 
 	default:
-		fmt.Fprintf(os.Stderr, "Unknown watchlist action: %s\n", action)
+		fmt.Fprintf(os.Stderr, "Unknown favorite action: %s\n", action)
 		os.Exit(1)
 	}
 }
 
-func doSeasons(args []string, jsonFlag bool) {
+func doSeasons(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	resolve := ctx.Bool("resolve")
 	if len(args) == 0 {
-		fmt.Fprintln(os.Stderr, "Usage: themoviedb-cli seasons <series_id>")
-		os.Exit(1)
+		usageExit(ctx)
 	}
-	id, err := strconv.Atoi(args[0])
-	exitOnErr(err)
 	client := mustClient()
-	details, err := client.TVDetails(id)
+	ref, err := refOnly(client, args, resolve, "tv")
+	exitOnErr(err)
+	details, err := client.TVDetails(ref.ID)
 	exitOnErr(err)
 	output.Seasons(details.Seasons, details.Name, jsonFlag)
 }
 
-func doEpisodes(args []string, jsonFlag bool) {
-	if len(args) < 2 {
-		fmt.Fprintln(os.Stderr, "Usage: themoviedb-cli episodes <series_id> <season_number>")
+func doEpisodes(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	group := ctx.String("group")
+	resolve := ctx.Bool("resolve")
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
+	client := mustClient()
+	ref, rest, err := readRef(client, args, resolve, "tv")
+	exitOnErr(err)
+	seasonNum := ref.Season
+	if !ref.HasSeason {
+		if len(rest) == 0 {
+			usageExit(ctx)
+		}
+		seasonNum, err = strconv.Atoi(rest[0])
+		exitOnErr(err)
+	}
+	if group != "" {
+		details, err := seriesEpisodeGroup(client, group, ref.ID)
+		exitOnErr(err)
+		season, err := details.Season(seasonNum)
+		exitOnErr(err)
+		output.GroupEpisodes(season, seasonNum, jsonFlag)
+		return
+	}
+	details, err := client.SeasonDetails(ref.ID, seasonNum)
+	exitOnErr(err)
+	output.Episodes(details.Episodes, details.Name, jsonFlag)
+}
+
+func doEpisode(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	group := ctx.String("group")
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
+	client := mustClient()
+	ref, err := refOnly(client, args, false, "tv")
+	exitOnErr(err)
+	if ref.Episode == 0 {
+		fmt.Fprintln(os.Stderr, "Missing episode (use S01E02)")
 		os.Exit(1)
 	}
-	seriesID, err := strconv.Atoi(args[0])
+	season, episode, err := groupEpisode(client, ref, group)
 	exitOnErr(err)
-	seasonNum, err := strconv.Atoi(args[1])
+	details, err := client.EpisodeDetails(ref.ID, season, episode)
 	exitOnErr(err)
+	if details.ShowID == 0 {
+		details.ShowID = ref.ID
+	}
+	output.Episode(details, jsonFlag)
+}
+
+func doEpisodeGroups(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
 	client := mustClient()
-	details, err := client.SeasonDetails(seriesID, seasonNum)
+	seriesID, err := resolveID(client, "tv", args[0])
 	exitOnErr(err)
-	output.Episodes(details.Episodes, details.Name, jsonFlag)
+	groups, err := client.EpisodeGroups(seriesID)
+	exitOnErr(err)
+	output.EpisodeGroups(groups, jsonFlag)
+}
+
+// groupEpisode returns the canonical season and episode of an episode
+// reference. With an episode group ID the reference's S01E02 is read in that
+// group's ordering and mapped to the numbers the API expects.
+func groupEpisode(client *api.Client, ref api.MediaRef, group string) (int, int, error) {
+	if group == "" {
+		return ref.Season, ref.Episode, nil
+	}
+	details, err := seriesEpisodeGroup(client, group, ref.ID)
+	if err != nil {
+		return 0, 0, err
+	}
+	e, err := details.Episode(ref.Season, ref.Episode)
+	if err != nil {
+		return 0, 0, err
+	}
+	return e.SeasonNumber, e.EpisodeNumber, nil
+}
+
+// seriesEpisodeGroup fetches an episode group and checks it is an ordering
+// of seriesID.
+func seriesEpisodeGroup(client *api.Client, group string, seriesID int) (*api.EpisodeGroupDetails, error) {
+	details, err := client.EpisodeGroupDetails(group)
+	if err != nil {
+		return nil, err
+	}
+	if id := details.ShowID(); id != 0 && id != seriesID {
+		return nil, fmt.Errorf("episode group %s belongs to series %d, not %d", group, id, seriesID)
+	}
+	return details, nil
 }
 
-func doRated(args []string, jsonFlag bool) {
+func doRated(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
 	// Parse: rated [movie|tv] [all|ytd|last N|from YYYY-MM-DD]
 	mediaType := "movie"
 	filterMode := "all"
@@ -532,20 +2835,6 @@ func parseEpisodeCode(code string) (int, int, error) {
 	return season, episode, nil
 }
 
-func hasFlag(args *[]string, flag string) bool {
-	filtered := make([]string, 0, len(*args))
-	found := false
-	for _, a := range *args {
-		if a == flag {
-			found = true
-		} else {
-			filtered = append(filtered, a)
-		}
-	}
-	*args = filtered
-	return found
-}
-
 // extractJWTSub extracts the "sub" claim from a JWT token (no verification).
 func extractJWTSub(token string) string {
 	parts := strings.Split(token, ".")
@@ -570,23 +2859,90 @@ func extractJWTSub(token string) string {
 	return claims.Sub
 }
 
-func doInfo(args []string) {
-	if len(args) < 2 || args[0] != "movie" {
-		fmt.Fprintln(os.Stderr, "Usage: themoviedb-cli info movie <id>")
+func doInfo(ctx *cli.Context) {
+	// info has always printed JSON; --text is the opt-in summary.
+	args, jsonFlag := ctx.Args, !ctx.Bool("text")
+	if ctx.Bool("text") && ctx.Bool("json") {
+		fmt.Fprintln(os.Stderr, "--text and --json can't be used together")
 		os.Exit(1)
 	}
-	id, err := strconv.Atoi(args[1])
+	region := strings.ToUpper(ctx.String("region"))
+	if region == "" {
+		region = "US"
+	}
+	if len(args) == 0 {
+		usageExit(ctx)
+	}
+	client := mustClient()
+	region = mustRegion(client, "--region", region)
+	ref, err := refOnly(client, args, false, "movie", "tv")
+	exitOnErr(err)
+	id := ref.ID
+	if ref.Kind == "tv" {
+		details, err := client.TVDetails(id, api.InfoExtras...)
+		exitOnErr(err)
+		output.TVDetails(details, region, jsonFlag)
+		return
+	}
+	info, err := client.GetMovieInfo(id, api.InfoExtras...)
+	exitOnErr(err)
+	output.MovieDetails(info, region, jsonFlag)
+}
+
+func doGenres(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	mediaType := "movie"
+	if len(args) > 0 {
+		mediaType = args[0]
+	}
+	if mediaType != "movie" && mediaType != "tv" {
+		usageExit(ctx)
+	}
+	client := mustClient()
+	genres, err := client.Genres(mediaType)
 	exitOnErr(err)
+	output.Genres(genres, jsonFlag)
+}
+
+func doCertifications(ctx *cli.Context) {
+	args, jsonFlag := ctx.Args, ctx.Bool("json")
+	region := strings.ToUpper(ctx.String("region"))
+	mediaType := "movie"
+	if len(args) > 0 {
+		mediaType = args[0]
+	}
+	if mediaType != "movie" && mediaType != "tv" {
+		usageExit(ctx)
+	}
 	client := mustClient()
-	info, err := client.GetMovieInfo(id)
+	region = mustRegion(client, "--region", region)
+	certs, err := client.Certifications(mediaType)
 	exitOnErr(err)
-	data, _ := json.MarshalIndent(info, "", "  ")
-	fmt.Println(string(data))
+	output.Certifications(certs, region, jsonFlag)
+}
+
+// usageExit prints the command's help to stderr and exits, for handlers
+// given arguments they can't use.
+func usageExit(ctx *cli.Context) {
+	ctx.Help(os.Stderr)
+	os.Exit(1)
 }
 
+// exitAmbiguous is the exit status when a title matches several entries.
+const exitAmbiguous = 2
+
+// jsonErrors is set from --json so exitOnErr lists ambiguous candidates as
+// JSON too.
+var jsonErrors bool
+
 func exitOnErr(err error) {
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		var amb *api.AmbiguousError
+		if errors.As(err, &amb) {
+			output.Multi(amb.Candidates, jsonErrors)
+			os.Exit(exitAmbiguous)
+		}
 		os.Exit(1)
 	}
 }
@@ -1,7 +1,13 @@
 package main
 
 import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/yareeh/themoviedb-cli/internal/api"
 )
@@ -49,42 +55,51 @@ func TestParseEpisodeCode(t *testing.T) {
 	}
 }
 
-func TestHasFlag(t *testing.T) {
+func TestParseYearRange(t *testing.T) {
 	tests := []struct {
-		name     string
-		args     []string
-		flag     string
-		want     bool
-		wantArgs []string
+		in       string
+		from, to int
+		wantErr  bool
 	}{
-		{"flag present", []string{"search", "--json", "query"}, "--json", true, []string{"search", "query"}},
-		{"flag absent", []string{"search", "query"}, "--json", false, []string{"search", "query"}},
-		{"empty args", []string{}, "--json", false, []string{}},
-		{"flag only", []string{"--json"}, "--json", true, []string{}},
-		{"multiple flags", []string{"--json", "--json"}, "--json", true, []string{}},
+		{"1999", 1999, 1999, false},
+		{"1990-1999", 1990, 1999, false},
+		{"1999-1990", 0, 0, true},
+		{"90s", 0, 0, true},
+		{"1990-", 0, 0, true},
 	}
 
 	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			args := make([]string, len(tt.args))
-			copy(args, tt.args)
-			got := hasFlag(&args, tt.flag)
-			if got != tt.want {
-				t.Errorf("hasFlag(%v, %q) = %v, want %v", tt.args, tt.flag, got, tt.want)
-			}
-			if len(args) != len(tt.wantArgs) {
-				t.Errorf("after hasFlag, args = %v, want %v", args, tt.wantArgs)
+		t.Run(tt.in, func(t *testing.T) {
+			from, to, err := parseYearRange(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseYearRange(%q) expected error", tt.in)
+				}
 				return
 			}
-			for i, a := range args {
-				if a != tt.wantArgs[i] {
-					t.Errorf("after hasFlag, args[%d] = %q, want %q", i, a, tt.wantArgs[i])
-				}
+			if err != nil || from != tt.from || to != tt.to {
+				t.Errorf("parseYearRange(%q) = (%d, %d, %v), want (%d, %d)", tt.in, from, to, err, tt.from, tt.to)
 			}
 		})
 	}
 }
 
+func TestResolveGenres(t *testing.T) {
+	genres := []api.Genre{{ID: 18, Name: "Drama"}, {ID: 35, Name: "Comedy"}}
+
+	ids, err := resolveGenres(genres, "drama, 35")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != 18 || ids[1] != 35 {
+		t.Errorf("resolveGenres = %v, want [18 35]", ids)
+	}
+
+	if _, err := resolveGenres(genres, "Western"); err == nil {
+		t.Error("expected error for unknown genre")
+	}
+}
+
 func TestExtractJWTSub(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -196,3 +211,478 @@ func TestFilterRatedTV(t *testing.T) {
 		}
 	})
 }
+
+func TestExcludeMovies(t *testing.T) {
+	movies := []api.MovieResult{{ID: 1}, {ID: 2}, {ID: 3}}
+	result := excludeMovies(movies, map[int]bool{2: true})
+	if len(result) != 2 || result[0].ID != 1 || result[1].ID != 3 {
+		t.Errorf("excludeMovies = %v, want IDs 1,3", result)
+	}
+	if got := excludeMovies(movies, nil); len(got) != 3 {
+		t.Errorf("excludeMovies with nil set: got %d, want 3", len(got))
+	}
+}
+
+func TestExcludeTV(t *testing.T) {
+	shows := []api.TVResult{{ID: 1396}, {ID: 1399}}
+	result := excludeTV(shows, map[int]bool{1396: true})
+	if len(result) != 1 || result[0].ID != 1399 {
+		t.Errorf("excludeTV = %v, want ID 1399", result)
+	}
+}
+
+func TestParseSearchQuery(t *testing.T) {
+	tests := []struct {
+		input   string
+		kind    string
+		query   string
+		year    int
+		lang    string
+		region  string
+		wantErr bool
+	}{
+		{"The Matrix", "", "The Matrix", 0, "", "", false},
+		{"movie:Dune y:2021", "movie", "Dune", 2021, "", "", false},
+		{"tv: Breaking Bad year:2008", "tv", "Breaking Bad", 2008, "", "", false},
+		{"movie:Tuntematon sotilas lang:fi region:fi", "movie", "Tuntematon sotilas", 0, "fi", "FI", false},
+		{"Star Trek: Picard", "", "Star Trek: Picard", 0, "", "", false},
+		{"collection:The Matrix", "collection", "The Matrix", 0, "", "", false},
+		{"keyword:time travel", "keyword", "time travel", 0, "", "", false},
+		{"movie:Dune y:later", "", "", 0, "", "", true},
+		{"movie: y:2021", "", "", 0, "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			kind, query, opts, err := parseSearchQuery(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseSearchQuery(%q) expected error", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseSearchQuery(%q) unexpected error: %v", tt.input, err)
+			}
+			if kind != tt.kind || query != tt.query {
+				t.Errorf("parseSearchQuery(%q) = (%q, %q), want (%q, %q)", tt.input, kind, query, tt.kind, tt.query)
+			}
+			if opts.Year != tt.year || opts.Language != tt.lang || opts.Region != tt.region {
+				t.Errorf("parseSearchQuery(%q) opts = %+v", tt.input, opts)
+			}
+		})
+	}
+}
+
+func TestFilterMultiByYear(t *testing.T) {
+	results := []api.MultiResult{
+		{MediaType: "movie", Movie: &api.MovieResult{ID: 1, ReleaseDate: "2021-09-15"}},
+		{MediaType: "movie", Movie: &api.MovieResult{ID: 2, ReleaseDate: "1984-12-14"}},
+		{MediaType: "tv", TV: &api.TVResult{ID: 3, FirstAirDate: "2021-01-01"}},
+		{MediaType: "person", Person: &api.PersonResult{ID: 4}},
+	}
+	filtered := filterMultiByYear(results, 2021)
+	if len(filtered) != 3 {
+		t.Fatalf("got %d results, want 3", len(filtered))
+	}
+	if filtered[1].TV == nil || filtered[2].Person == nil {
+		t.Errorf("unexpected results: %+v", filtered)
+	}
+	if got := filterMultiByYear(results, 0); len(got) != 4 {
+		t.Errorf("year 0: got %d, want 4", len(got))
+	}
+}
+
+func TestMatchesProvider(t *testing.T) {
+	providers := []api.WatchProvider{
+		{ProviderID: 8, ProviderName: "Netflix"},
+		{ProviderID: 323, ProviderName: "Yle Areena"},
+	}
+	tests := []struct {
+		name   string
+		wanted []string
+		want   bool
+	}{
+		{"name", []string{"netflix"}, true},
+		{"name fragment", []string{"yle"}, true},
+		{"id", []string{"323"}, true},
+		{"no match", []string{"disney", "337"}, false},
+		{"empty", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesProvider(providers, tt.wanted); got != tt.want {
+				t.Errorf("matchesProvider(%v) = %v, want %v", tt.wanted, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSeasonOrEpisode(t *testing.T) {
+	tests := []struct {
+		code    string
+		season  int
+		episode int
+		wantErr bool
+	}{
+		{"S02", 2, 0, false},
+		{"s5", 5, 0, false},
+		{"S05E16", 5, 16, false},
+		{"5", 0, 0, true},
+		{"Sx", 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			season, episode, err := parseSeasonOrEpisode(tt.code)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseSeasonOrEpisode(%q) expected error", tt.code)
+				}
+				return
+			}
+			if err != nil || season != tt.season || episode != tt.episode {
+				t.Errorf("parseSeasonOrEpisode(%q) = (%d, %d, %v), want (%d, %d)", tt.code, season, episode, err, tt.season, tt.episode)
+			}
+		})
+	}
+}
+
+func TestFilterImages(t *testing.T) {
+	images := []api.TypedImage{
+		{Kind: "poster", Image: api.Image{FilePath: "/a.jpg", Width: 2000, Language: "en"}},
+		{Kind: "poster", Image: api.Image{FilePath: "/b.jpg", Width: 500, Language: "fi"}},
+		{Kind: "backdrop", Image: api.Image{FilePath: "/c.jpg", Width: 3840, Language: ""}},
+	}
+	tests := []struct {
+		name     string
+		kind     string
+		lang     string
+		minWidth int
+		want     []string
+	}{
+		{"all", "", "", 0, []string{"/a.jpg", "/b.jpg", "/c.jpg"}},
+		{"posters", "poster", "", 0, []string{"/a.jpg", "/b.jpg"}},
+		{"language", "", "fi", 0, []string{"/b.jpg"}},
+		{"textless", "", "none", 0, []string{"/c.jpg"}},
+		{"min width", "poster", "", 1000, []string{"/a.jpg"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterImages(images, tt.kind, tt.lang, tt.minWidth)
+			var paths []string
+			for _, img := range got {
+				paths = append(paths, img.FilePath)
+			}
+			if strings.Join(paths, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("filterImages() = %v, want %v", paths, tt.want)
+			}
+		})
+	}
+}
+
+func TestImageFileName(t *testing.T) {
+	tests := []struct {
+		target   imageTarget
+		kind     string
+		size     string
+		filePath string
+		want     string
+	}{
+		{imageTarget{mediaType: "movie", id: 603}, "poster", "w500", "/abc.jpg", "movie-603-poster-w500.jpg"},
+		{imageTarget{mediaType: "tv", id: 1396, hasSeason: true, season: 5, episode: 16}, "still", "original", "/x.png", "tv-1396-S05E16-still-original.png"},
+		{imageTarget{mediaType: "tv", id: 1396, hasSeason: true, season: 5}, "poster", "w500", "/s.jpg", "tv-1396-S05-poster-w500.jpg"},
+		{imageTarget{mediaType: "person", id: 287}, "profile", "h632", "", "person-287-profile-h632.jpg"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := imageFileName(tt.target, tt.kind, tt.size, tt.filePath); got != tt.want {
+				t.Errorf("imageFileName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteIfChanged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "poster.jpg")
+
+	status, err := writeIfChanged(path, []byte("one"))
+	if err != nil || status != "saved" {
+		t.Fatalf("first write = (%q, %v), want saved", status, err)
+	}
+	status, err = writeIfChanged(path, []byte("one"))
+	if err != nil || status != "unchanged" {
+		t.Errorf("same content = (%q, %v), want unchanged", status, err)
+	}
+	status, err = writeIfChanged(path, []byte("two"))
+	if err != nil || status != "updated" {
+		t.Errorf("new content = (%q, %v), want updated", status, err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "two" {
+		t.Errorf("file content = %q, want two", data)
+	}
+}
+
+func TestFilterCrew(t *testing.T) {
+	crew := []api.CrewMember{
+		{Name: "Lana Wachowski", Department: "Directing", Job: "Director"},
+		{Name: "Lana Wachowski", Department: "Writing", Job: "Writer"},
+		{Name: "Don Davis", Department: "Sound", Job: "Original Music Composer"},
+	}
+	department := func(c api.CrewMember) string { return c.Department }
+	if got := filterCrew(crew, "", department); len(got) != 3 {
+		t.Errorf("empty department kept %d, want 3", len(got))
+	}
+	got := filterCrew(crew, "writing", department)
+	if len(got) != 1 || got[0].Job != "Writer" {
+		t.Errorf("filterCrew(writing) = %+v", got)
+	}
+}
+
+func TestChangeWindows(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	start, end, err := parseChangeWindow("", "", 0, now)
+	if err != nil {
+		t.Fatalf("parseChangeWindow error: %v", err)
+	}
+	if got := changeWindows(start, end); len(got) != 1 || got[0] != [2]string{"2026-10-11", "2026-10-18"} {
+		t.Errorf("default window = %v", got)
+	}
+
+	start, end, err = parseChangeWindow("2026-09-01", "2026-10-01", 0, now)
+	if err != nil {
+		t.Fatalf("parseChangeWindow error: %v", err)
+	}
+	want := [][2]string{
+		{"2026-09-01", "2026-09-15"},
+		{"2026-09-16", "2026-09-30"},
+		{"2026-10-01", "2026-10-01"},
+	}
+	got := changeWindows(start, end)
+	if len(got) != len(want) {
+		t.Fatalf("changeWindows = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("window %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if _, _, err := parseChangeWindow("2026-10-02", "2026-10-01", 0, now); err == nil {
+		t.Error("expected error for --from after --to")
+	}
+}
+
+func TestCheckLanguageCodeRejectsRegion(t *testing.T) {
+	for _, lang := range []string{"en-US", "fi-FI"} {
+		if _, err := checkLanguageCode(nil, "--lang", lang); err == nil {
+			t.Errorf("checkLanguageCode(%q) accepted a region", lang)
+		}
+	}
+}
+
+func TestSuggestCodes(t *testing.T) {
+	names := map[string]string{"FI": "Finland", "FR": "France", "SE": "Sweden", "IN": "India", "FJ": "Fiji"}
+	tests := []struct {
+		input string
+		want  string // first suggestion
+	}{
+		{"Finland", "FI (Finland)"},
+		{"fin", "FI (Finland)"},
+		{"Swedn", "SE (Sweden)"},
+		{"FN", "FI (Finland)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got := suggestCodes(tt.input, names)
+			if len(got) == 0 || got[0] != tt.want {
+				t.Errorf("suggestCodes(%q) = %v, want first %q", tt.input, got, tt.want)
+			}
+			if len(got) > 3 {
+				t.Errorf("suggestCodes(%q) returned %d suggestions, want at most 3", tt.input, len(got))
+			}
+		})
+	}
+	if got := suggestCodes("XYZQ", names); len(got) != 0 {
+		t.Errorf("suggestCodes(XYZQ) = %v, want none", got)
+	}
+}
+
+func TestIsTitleQuery(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"The Matrix (1999)", true},
+		{"Mission: Impossible", true},
+		{"tv:1396/X5", false},
+		{"[movie:abc]", false},
+		{"https://www.themoviedb.org/movie/abc", false},
+	}
+	for _, tt := range tests {
+		if got := isTitleQuery(tt.in); got != tt.want {
+			t.Errorf("isTitleQuery(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+// roundTripFunc serves canned API responses without a network.
+type roundTripFunc func(*http.Request) *http.Response
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r), nil
+}
+
+func fakeClient(responses map[string]string) *api.Client {
+	c := api.New("tok", "", 0, "")
+	c.SetHTTPClient(&http.Client{Transport: roundTripFunc(func(r *http.Request) *http.Response {
+		body, ok := responses[r.URL.Path]
+		status := http.StatusOK
+		if !ok {
+			status, body = http.StatusNotFound, `{"status_message":"not found"}`
+		}
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body)), Header: http.Header{}}
+	})})
+	return c
+}
+
+func TestResolveID(t *testing.T) {
+	client := fakeClient(map[string]string{
+		"/3/find/tt0133093": `{"movie_results":[{"id":603}],"tv_results":[],"person_results":[]}`,
+		"/3/find/tt0903747": `{"movie_results":[],"tv_results":[{"id":1396}],"person_results":[]}`,
+		"/3/find/nm0000093": `{"movie_results":[],"tv_results":[],"person_results":[{"id":287}]}`,
+		"/3/find/tt0000001": `{"movie_results":[],"tv_results":[],"person_results":[]}`,
+	})
+	tests := []struct {
+		kind, arg string
+		want      int
+		wantErr   bool
+	}{
+		{"movie", "603", 603, false},
+		{"movie", "movie:603", 603, false},
+		{"movie", "tt0133093", 603, false},
+		{"tv", "tt0903747", 1396, false},
+		{"person", "nm0000093", 287, false},
+		{"tv", "tt0133093", 0, true},    // a movie's IMDb ID
+		{"movie", "tt0000001", 0, true}, // unknown to TMDB
+		{"movie", "tv:1396", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := resolveID(client, tt.kind, tt.arg)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("resolveID(%s, %s) = %d, want error", tt.kind, tt.arg, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("resolveID(%s, %s) = %d, %v, want %d", tt.kind, tt.arg, got, err, tt.want)
+		}
+	}
+}
+
+func TestLoadImageConfigRemembersFailure(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	calls := 0
+	client := api.New("tok", "", 0, "")
+	client.SetHTTPClient(&http.Client{Transport: roundTripFunc(func(r *http.Request) *http.Response {
+		calls++
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader("{}")), Header: http.Header{}}
+	})})
+	for range 2 {
+		if got := loadImageConfig(client); got.SecureBaseURL != api.DefaultImageConfig().SecureBaseURL {
+			t.Errorf("loadImageConfig = %+v, want the default", got)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("configuration requested %d times, want 1", calls)
+	}
+}
+
+func TestDownloadImage(t *testing.T) {
+	fetched := 0
+	client := api.New("tok", "", 0, "")
+	client.SetHTTPClient(&http.Client{Transport: roundTripFunc(func(r *http.Request) *http.Response {
+		body := `{"posters":[{"file_path":"/low.jpg","vote_average":5.1},{"file_path":"/top.jpg","vote_average":5.8}]}`
+		if r.URL.Host == "image.tmdb.org" {
+			fetched++
+			body = "image " + r.URL.Path
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: http.Header{}}
+	})})
+	dir := t.TempDir()
+	sources := map[string]imageSource{}
+	target := imageTarget{mediaType: "movie", id: 603}
+
+	result, err := downloadImage(client, target, "poster", "", "w9999", dir, sources)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(dir, "movie-603-poster-original.jpg"); result.File != want || result.Status != "saved" {
+		t.Errorf("first download = %+v, want %s saved", result, want)
+	}
+	if !strings.HasSuffix(result.URL, "/original/top.jpg") {
+		t.Errorf("URL = %q, want the top-voted poster at original size", result.URL)
+	}
+
+	result, err = downloadImage(client, target, "poster", "", "w9999", dir, sources)
+	if err != nil || result.Status != "unchanged" || fetched != 1 {
+		t.Errorf("second download = %+v, %v after %d fetches, want unchanged without fetching", result, err, fetched)
+	}
+}
+
+func TestRefOnlyRejectsLeftovers(t *testing.T) {
+	if ref, err := refOnly(nil, []string{"movie", "603"}, false, "movie", "tv"); err != nil || ref.ID != 603 {
+		t.Errorf("refOnly(movie 603) = %v, %v", ref, err)
+	}
+	if _, err := refOnly(nil, []string{"movie:603", "extra"}, false, "movie", "tv"); err == nil {
+		t.Error("refOnly accepted an argument after the reference")
+	}
+}
+
+func TestLookupExternalIDsKeepsQuotedTitle(t *testing.T) {
+	client := fakeClient(map[string]string{
+		"/3/search/movie":           `{"results":[{"id":603,"title":"The Matrix","release_date":"1999-03-31"}]}`,
+		"/3/movie/603/external_ids": `{"id":603,"imdb_id":"tt0133093"}`,
+	})
+	ref, ids, err := lookupExternalIDs(client, "movie", []string{"The Matrix"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ref.ID != 603 || ids.IMDbID != "tt0133093" {
+		t.Errorf("lookupExternalIDs = %v, %+v", ref, ids)
+	}
+}
+
+func TestIsReviewID(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want bool
+	}{
+		{"5b0e8d1b0e0a2625a7012d26", true},
+		{"review:5b0e8d1b0e0a2625a7012d26", true},
+		{"[review:5b0e8d1b0e0a2625a7012d26]", true},
+		{"The Matrix", false},
+		{"Inception", false},
+		{"603", false},
+		{"movie:603", false},
+	}
+	for _, tt := range tests {
+		if got := isReviewID(tt.arg); got != tt.want {
+			t.Errorf("isReviewID(%q) = %v, want %v", tt.arg, got, tt.want)
+		}
+	}
+}
+
+func TestReviewsTitleResolves(t *testing.T) {
+	client := fakeClient(map[string]string{
+		"/3/search/movie": `{"results":[{"id":603,"title":"The Matrix","release_date":"1999-03-31","popularity":80}]}`,
+		"/3/search/tv":    `{"results":[]}`,
+	})
+	ref, err := refOnly(client, []string{"The Matrix"}, false, "movie", "tv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ref.Kind != "movie" || ref.ID != 603 {
+		t.Errorf("refOnly(The Matrix) = %v, want movie:603", ref)
+	}
+}